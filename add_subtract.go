@@ -1,6 +1,7 @@
 package goment
 
 import (
+	"errors"
 	"time"
 )
 
@@ -10,38 +11,69 @@ func (g *Goment) Add(args ...interface{}) *Goment {
 		switch val := args[0].(type) {
 		case time.Duration:
 			g.addDuration(val)
+		case *Duration:
+			if val != nil {
+				g.addCalendarDuration(val, 1)
+			}
+		case Duration:
+			g.addCalendarDuration(&val, 1)
+		case string:
+			if d, err := parseISODuration(val); err == nil {
+				g.addCalendarDuration(d, 1)
+			}
 		case int:
 			if len(args) == 2 {
-				if units, ok := args[1].(string); ok {
-					switch units {
-					case "y", "year", "years":
-						g.addYears(val)
-					case "Q", "quarter", "quarters":
-						g.addQuarters(val)
-					case "M", "month", "months":
-						g.addMonths(val)
-					case "w", "week", "weeks":
-						g.addWeeks(val)
-					case "d", "day", "days":
-						g.addDays(val)
-					case "h", "hour", "hours":
-						g.addHours(val)
-					case "m", "minute", "minutes":
-						g.addMinutes(val)
-					case "s", "second", "seconds":
-						g.addSeconds(val)
-					case "ms", "millisecond", "milliseconds":
-						g.addMilliseconds(val)
-					case "ns", "nanosecond", "nanoseconds":
-						g.addNanoseconds(val)
-					}
-				}
+				g.AddUnit(val, unitFromArg(args[1]))
 			}
 		}
 	}
 	return g
 }
 
+// AddE mutates the original Goment by adding time, like Add. An error is returned, and the Goment is unchanged, if the
+// arguments are not valid.
+func (g *Goment) AddE(args ...interface{}) (*Goment, error) {
+	if err := validateManipulateArgs(args); err != nil {
+		return g, err
+	}
+	return g.Add(args...), nil
+}
+
+// AddUnit mutates the original Goment by adding an amount of the unit.
+func (g *Goment) AddUnit(amount int, unit Unit) *Goment {
+	switch unit {
+	case Year:
+		g.addYears(amount)
+	case Quarter:
+		g.addQuarters(amount)
+	case Month:
+		g.addMonths(amount)
+	case Week, ISOWeek:
+		g.addWeeks(amount)
+	case Day:
+		g.addDays(amount)
+	case Hour:
+		g.addHours(amount)
+	case Minute:
+		g.addMinutes(amount)
+	case Second:
+		g.addSeconds(amount)
+	case Millisecond:
+		g.addMilliseconds(amount)
+	case Nanosecond:
+		g.addNanoseconds(amount)
+	case JalaliYear:
+		g.addCalendarMonths(jalali, amount*12)
+	case JalaliMonth:
+		g.addCalendarMonths(jalali, amount)
+	case HijriYear:
+		g.addCalendarMonths(g.hijri, amount*12)
+	case HijriMonth:
+		g.addCalendarMonths(g.hijri, amount)
+	}
+	return g
+}
+
 func (g *Goment) addYears(years int) *Goment {
 	g.time = g.ToTime().AddDate(years, 0, 0)
 	return g
@@ -90,84 +122,88 @@ func (g *Goment) addDuration(d time.Duration) *Goment {
 	return g
 }
 
+// addCalendarDuration adds the months, days & time of the Duration, in that order, multiplied by the sign.
+func (g *Goment) addCalendarDuration(d *Duration, sign int) *Goment {
+	return g.addMonths(d.months * sign).addDays(d.days * sign).addDuration(time.Duration(d.nanoseconds * int64(sign)))
+}
+
 // Subtract mutates the original Goment by subtracting time.
 func (g *Goment) Subtract(args ...interface{}) *Goment {
 	if len(args) > 0 {
 		switch val := args[0].(type) {
 		case time.Duration:
 			g.subtractDuration(val)
+		case *Duration:
+			if val != nil {
+				g.addCalendarDuration(val, -1)
+			}
+		case Duration:
+			g.addCalendarDuration(&val, -1)
+		case string:
+			if d, err := parseISODuration(val); err == nil {
+				g.addCalendarDuration(d, -1)
+			}
 		case int:
 			if len(args) == 2 {
-				if units, ok := args[1].(string); ok {
-					switch units {
-					case "y", "year", "years":
-						g.subtractYears(val)
-					case "Q", "quarter", "quarters":
-						g.subtractQuarters(val)
-					case "M", "month", "months":
-						g.subtractMonths(val)
-					case "w", "week", "weeks":
-						g.subtractWeeks(val)
-					case "d", "day", "days":
-						g.subtractDays(val)
-					case "h", "hour", "hours":
-						g.subtractHours(val)
-					case "m", "minute", "minutes":
-						g.subtractMinutes(val)
-					case "s", "second", "seconds":
-						g.subtractSeconds(val)
-					case "ms", "millisecond", "milliseconds":
-						g.subtractMilliseconds(val)
-					case "ns", "nanosecond", "nanoseconds":
-						g.subtractNanoseconds(val)
-					}
-				}
+				g.SubtractUnit(val, unitFromArg(args[1]))
 			}
 		}
 	}
 	return g
 }
 
-func (g *Goment) subtractYears(years int) *Goment {
-	return g.addYears(years * -1)
-}
-
-func (g *Goment) subtractQuarters(quarters int) *Goment {
-	return g.addQuarters(quarters * -1)
-}
-
-func (g *Goment) subtractMonths(months int) *Goment {
-	return g.addMonths(months * -1)
-}
-
-func (g *Goment) subtractWeeks(weeks int) *Goment {
-	return g.addWeeks(weeks * -1)
-}
-
-func (g *Goment) subtractDays(days int) *Goment {
-	return g.addDays(days * -1)
-}
-
-func (g *Goment) subtractHours(hours int) *Goment {
-	return g.addHours(hours * -1)
+// SubtractE mutates the original Goment by subtracting time, like Subtract. An error is returned, and the Goment is
+// unchanged, if the arguments are not valid.
+func (g *Goment) SubtractE(args ...interface{}) (*Goment, error) {
+	if err := validateManipulateArgs(args); err != nil {
+		return g, err
+	}
+	return g.Subtract(args...), nil
 }
 
-func (g *Goment) subtractMinutes(minutes int) *Goment {
-	return g.addMinutes(minutes * -1)
+// SubtractUnit mutates the original Goment by subtracting an amount of the unit.
+func (g *Goment) SubtractUnit(amount int, unit Unit) *Goment {
+	return g.AddUnit(amount*-1, unit)
 }
 
-func (g *Goment) subtractSeconds(seconds int) *Goment {
-	return g.addSeconds(seconds * -1)
+func (g *Goment) subtractDuration(d time.Duration) *Goment {
+	return g.addDuration(d * -1)
 }
 
-func (g *Goment) subtractMilliseconds(milliseconds int) *Goment {
-	return g.addMilliseconds(milliseconds * -1)
-}
+func validateManipulateArgs(args []interface{}) error {
+	if len(args) == 0 {
+		return errors.New("Invalid number of arguments")
+	}
 
-func (g *Goment) subtractNanoseconds(nanoseconds int) *Goment {
-	return g.addNanoseconds(nanoseconds * -1)
-}
+	switch v := args[0].(type) {
+	case *Duration:
+		if v == nil {
+			return errors.New("Invalid argument type")
+		}
+		if len(args) != 1 {
+			return errors.New("Invalid number of arguments")
+		}
+	case time.Duration, Duration:
+		if len(args) != 1 {
+			return errors.New("Invalid number of arguments")
+		}
+	case string:
+		if len(args) != 1 {
+			return errors.New("Invalid number of arguments")
+		}
+		if _, err := parseISODuration(v); err != nil {
+			return err
+		}
+	case int:
+		if len(args) != 2 {
+			return errors.New("Invalid number of arguments")
+		}
+		if _, err := unitFromArgE(args[1]); err != nil {
+			return err
+		}
+	default:
+		return errors.New("Invalid argument type")
+	}
 
-func (g *Goment) subtractDuration(d time.Duration) *Goment {
-	return g.addDuration(d * -1)
+	return nil
 }
@@ -338,3 +338,50 @@ func TestSubtractNanoseconds(t *testing.T) {
 
 	assert.Equal(t, 400, lib.Nanosecond())
 }
+
+func TestAddE(t *testing.T) {
+	assert := assert.New(t)
+
+	lib := simpleTime(time.Date(2017, 1, 1, 15, 0, 0, 0, time.UTC))
+
+	_, err := lib.AddE(5, "yeers")
+	assert.EqualError(err, "Invalid unit yeers")
+	assert.Equal(2017, lib.Year())
+
+	_, err = lib.AddE(5, Unit(42))
+	assert.EqualError(err, "Invalid unit 42")
+
+	_, err = lib.AddE(5, 5)
+	assert.EqualError(err, "Units must be a string or Unit")
+
+	_, err = lib.AddE(5.5, "years")
+	assert.EqualError(err, "Invalid argument type")
+
+	_, err = lib.AddE(5)
+	assert.EqualError(err, "Invalid number of arguments")
+
+	_, err = lib.AddE()
+	assert.EqualError(err, "Invalid number of arguments")
+
+	added, err := lib.AddE(5, "years")
+	assert.Nil(err)
+	assert.Equal(2022, added.Year())
+
+	added, err = lib.AddE(time.Hour)
+	assert.Nil(err)
+	assert.Equal(16, added.Hour())
+}
+
+func TestSubtractE(t *testing.T) {
+	assert := assert.New(t)
+
+	lib := simpleTime(time.Date(2017, 1, 1, 15, 0, 0, 0, time.UTC))
+
+	_, err := lib.SubtractE(5, "yeers")
+	assert.EqualError(err, "Invalid unit yeers")
+	assert.Equal(2017, lib.Year())
+
+	subtracted, err := lib.SubtractE(5, Year)
+	assert.Nil(err)
+	assert.Equal(2012, subtracted.Year())
+}
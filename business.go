@@ -0,0 +1,214 @@
+package goment
+
+import "time"
+
+// HolidayCalendar decides which dates are holidays for the business day methods.
+type HolidayCalendar interface {
+	IsHoliday(g *Goment) bool
+}
+
+// HolidayFunc is a function that can be used as a HolidayCalendar.
+type HolidayFunc func(g *Goment) bool
+
+// IsHoliday calls the function.
+func (f HolidayFunc) IsHoliday(g *Goment) bool {
+	return f(g)
+}
+
+// HolidayDates is a HolidayCalendar with a fixed list of dates.
+type HolidayDates struct {
+	dates map[string]bool
+}
+
+// NewHolidayDates creates a HolidayDates from dates, which accept the same arguments as New.
+func NewHolidayDates(dates ...interface{}) (*HolidayDates, error) {
+	h := &HolidayDates{dates: map[string]bool{}}
+
+	for _, date := range dates {
+		g, err := New(date)
+		if err != nil {
+			return &HolidayDates{}, err
+		}
+		h.dates[holidayKey(g)] = true
+	}
+
+	return h, nil
+}
+
+// IsHoliday checks if the date of the Goment is one of the dates.
+func (h *HolidayDates) IsHoliday(g *Goment) bool {
+	return h.dates[holidayKey(g)]
+}
+
+func holidayKey(g *Goment) string {
+	return g.ToTime().Format("2006-01-02")
+}
+
+// BusinessCalendar defines the days that are not business days.
+type BusinessCalendar struct {
+	// Weekend is the days of the week that are not business days. Saturday & Sunday are used when it is nil.
+	Weekend []time.Weekday
+	// Holidays are the other days that are not business days. There are none when it is nil.
+	Holidays HolidayCalendar
+}
+
+var globalBusinessCalendar = BusinessCalendar{}
+
+// maxNonBusinessDays is the number of days in a row without a business day after which the business day methods give
+// up, so a HolidayCalendar that has no business days can't make them run forever.
+const maxNonBusinessDays = 366
+
+// SetBusinessCalendar sets the BusinessCalendar used by the business day methods when one is not passed.
+func SetBusinessCalendar(calendar BusinessCalendar) {
+	globalBusinessCalendar = calendar
+}
+
+// IsBusinessDay checks if the Goment is on a business day. A BusinessCalendar can be passed to use instead of the
+// global one.
+func (g *Goment) IsBusinessDay(calendar ...BusinessCalendar) bool {
+	return newBusinessDays(calendar).isBusinessDay(g, g.ToTime())
+}
+
+// AddBusinessDays adds business days to the Goment, keeping the time of day. Negative days are subtracted. A
+// BusinessCalendar can be passed to use instead of the global one. The Goment is unchanged if a year passes without a
+// business day.
+func (g *Goment) AddBusinessDays(days int, calendar ...BusinessCalendar) *Goment {
+	b := newBusinessDays(calendar)
+	if b.workdays == 0 {
+		return g
+	}
+
+	step := 1
+	if days < 0 {
+		step, days = -1, -days
+	}
+
+	t := g.ToTime()
+	offset := 0
+
+	// Without holidays, every week has the same number of business days, so whole weeks can be skipped.
+	if b.holidays == nil && days > b.workdays {
+		weeks := (days - 1) / b.workdays
+		offset = step * weeks * 7
+		days -= weeks * b.workdays
+	}
+
+	for skipped := 0; days > 0; {
+		offset += step
+		if b.isBusinessDay(g, t.AddDate(0, 0, offset)) {
+			days--
+			skipped = 0
+		} else if skipped++; skipped >= maxNonBusinessDays {
+			return g
+		}
+	}
+
+	g.time = t.AddDate(0, 0, offset)
+	return g
+}
+
+// SubtractBusinessDays subtracts business days from the Goment, keeping the time of day. A BusinessCalendar can be
+// passed to use instead of the global one.
+func (g *Goment) SubtractBusinessDays(days int, calendar ...BusinessCalendar) *Goment {
+	return g.AddBusinessDays(-days, calendar...)
+}
+
+// NextBusinessDay sets the Goment to the next business day, keeping the time of day. A BusinessCalendar can be passed
+// to use instead of the global one. The Goment is unchanged if there is no business day in the next year.
+func (g *Goment) NextBusinessDay(calendar ...BusinessCalendar) *Goment {
+	return g.AddBusinessDays(1, calendar...)
+}
+
+// PrevBusinessDay sets the Goment to the previous business day, keeping the time of day. A BusinessCalendar can be
+// passed to use instead of the global one. The Goment is unchanged if there is no business day in the previous year.
+func (g *Goment) PrevBusinessDay(calendar ...BusinessCalendar) *Goment {
+	return g.AddBusinessDays(-1, calendar...)
+}
+
+// BusinessDiff returns the number of business days from a date to the Goment, which is negative if the date is after
+// the Goment. The date accepts the same arguments as New, and is compared in the time zone of the Goment. The
+// Goment's day is counted & the date's day is not, so adding the result to the date gives the Goment's day. A
+// BusinessCalendar can be passed to use instead of the global one.
+func (g *Goment) BusinessDiff(date interface{}, calendar ...BusinessCalendar) int {
+	other, err := New(date)
+	if err != nil {
+		return 0
+	}
+
+	to := g.ToTime()
+	from := other.ToTime().In(to.Location())
+
+	days := civilDay(to) - civilDay(from)
+	if days < 0 {
+		return -newBusinessDays(calendar).count(g, to.AddDate(0, 0, -1), -days)
+	}
+	return newBusinessDays(calendar).count(g, from, days)
+}
+
+// businessDays is a BusinessCalendar prepared for the business day calculations.
+type businessDays struct {
+	weekend  [7]bool
+	workdays int
+	holidays HolidayCalendar
+}
+
+func newBusinessDays(calendar []BusinessCalendar) *businessDays {
+	c := globalBusinessCalendar
+	if len(calendar) > 0 {
+		c = calendar[0]
+	}
+
+	weekend := c.Weekend
+	if weekend == nil {
+		weekend = []time.Weekday{time.Saturday, time.Sunday}
+	}
+
+	b := &businessDays{holidays: c.Holidays, workdays: 7}
+	for _, day := range weekend {
+		if !b.weekend[day] {
+			b.weekend[day] = true
+			b.workdays--
+		}
+	}
+
+	return b
+}
+
+func (b *businessDays) isBusinessDay(g *Goment, t time.Time) bool {
+	if b.weekend[t.Weekday()] {
+		return false
+	}
+	if b.holidays == nil {
+		return true
+	}
+
+	day := g.Clone()
+	day.time = t
+	return !b.holidays.IsHoliday(day)
+}
+
+// count returns the number of business days in the days after a time.
+func (b *businessDays) count(g *Goment, start time.Time, days int) int {
+	count := 0
+
+	if b.holidays == nil {
+		weeks := days / 7
+		count = weeks * b.workdays
+		start = start.AddDate(0, 0, weeks*7)
+		days -= weeks * 7
+	}
+
+	for i := 1; i <= days; i++ {
+		if b.isBusinessDay(g, start.AddDate(0, 0, i)) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// civilDay returns the number of days from the Unix epoch to the date of a time, ignoring its time of day.
+func civilDay(t time.Time) int {
+	y, m, d := t.Date()
+	return int(time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / 86400)
+}
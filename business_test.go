@@ -0,0 +1,139 @@
+package goment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddBusinessDays(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("2024-01-08", simpleString("2024-01-05").AddBusinessDays(1).Format("YYYY-MM-DD"))
+	assert.Equal("2024-01-12", simpleString("2024-01-05").AddBusinessDays(5).Format("YYYY-MM-DD"))
+	assert.Equal("2024-01-17", simpleString("2024-01-03").AddBusinessDays(10).Format("YYYY-MM-DD"))
+	assert.Equal("2024-01-08", simpleString("2024-01-06").AddBusinessDays(1).Format("YYYY-MM-DD"))
+	assert.Equal("2024-01-06", simpleString("2024-01-06").AddBusinessDays(0).Format("YYYY-MM-DD"))
+	assert.Equal("2024-01-05", simpleString("2024-01-08").AddBusinessDays(-1).Format("YYYY-MM-DD"))
+	assert.Equal("2024-12-31", simpleString("2024-01-01").AddBusinessDays(261).Format("YYYY-MM-DD"))
+	assert.Equal("2024-01-05", simpleString("2024-01-08").SubtractBusinessDays(1).Format("YYYY-MM-DD"))
+	assert.Equal("2023-12-29", simpleString("2024-01-08").SubtractBusinessDays(6).Format("YYYY-MM-DD"))
+}
+
+func TestAddBusinessDaysSkipsWholeWeeks(t *testing.T) {
+	assert := assert.New(t)
+
+	// A holiday calendar without holidays steps through every day, so it checks the whole week skipping.
+	noHolidays := HolidayFunc(func(g *Goment) bool { return false })
+
+	for _, weekend := range [][]time.Weekday{nil, {time.Friday, time.Saturday}, {time.Sunday}, {}} {
+		for start := 1; start <= 7; start++ {
+			for days := -30; days <= 30; days++ {
+				g := simple(DateTime{Year: 2024, Month: 1, Day: start, Location: time.UTC})
+				fast := g.Clone().AddBusinessDays(days, BusinessCalendar{Weekend: weekend})
+				slow := g.Clone().AddBusinessDays(days, BusinessCalendar{Weekend: weekend, Holidays: noHolidays})
+				assert.Equal(slow.ToTime(), fast.ToTime())
+				assert.Equal(days, fast.BusinessDiff(g, BusinessCalendar{Weekend: weekend}))
+			}
+		}
+	}
+}
+
+func TestAddBusinessDaysWithHolidays(t *testing.T) {
+	assert := assert.New(t)
+
+	holidays, err := NewHolidayDates("2024-12-25", "2024-12-26", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	assert.Nil(err)
+	calendar := BusinessCalendar{Holidays: holidays}
+
+	assert.Equal("2024-12-27", simpleString("2024-12-24").AddBusinessDays(1, calendar).Format("YYYY-MM-DD"))
+	assert.Equal("2025-01-02", simpleString("2024-12-24").AddBusinessDays(4, calendar).Format("YYYY-MM-DD"))
+	assert.Equal("2024-12-24", simpleString("2024-12-27").SubtractBusinessDays(1, calendar).Format("YYYY-MM-DD"))
+	assert.False(simpleString("2024-12-25").IsBusinessDay(calendar))
+
+	_, err = NewHolidayDates("not a date")
+	assert.EqualError(err, "Not a matching ISO-8601 date")
+}
+
+func TestAddBusinessDaysWithWeekend(t *testing.T) {
+	assert := assert.New(t)
+
+	calendar := BusinessCalendar{Weekend: []time.Weekday{time.Friday, time.Saturday}}
+
+	assert.Equal("2024-01-07", simpleString("2024-01-04").AddBusinessDays(1, calendar).Format("YYYY-MM-DD"))
+	assert.True(simpleString("2024-01-07").IsBusinessDay(calendar))
+	assert.False(simpleString("2024-01-05").IsBusinessDay(calendar))
+
+	// With every day in the weekend there are no business days to move to.
+	calendar = BusinessCalendar{Weekend: []time.Weekday{0, 1, 2, 3, 4, 5, 6}}
+	assert.Equal("2024-01-04", simpleString("2024-01-04").AddBusinessDays(1, calendar).Format("YYYY-MM-DD"))
+}
+
+func TestAddBusinessDaysWithoutBusinessDays(t *testing.T) {
+	assert := assert.New(t)
+
+	// A calendar where every day is a holiday leaves the Goment unchanged instead of searching forever.
+	calendar := BusinessCalendar{Holidays: HolidayFunc(func(*Goment) bool { return true })}
+	assert.Equal("2024-01-04", simpleString("2024-01-04").AddBusinessDays(1, calendar).Format("YYYY-MM-DD"))
+	assert.Equal("2024-01-04", simpleString("2024-01-04").SubtractBusinessDays(3, calendar).Format("YYYY-MM-DD"))
+	assert.Equal("2024-01-04", simpleString("2024-01-04").NextBusinessDay(calendar).Format("YYYY-MM-DD"))
+	assert.Equal("2024-01-04", simpleString("2024-01-04").PrevBusinessDay(calendar).Format("YYYY-MM-DD"))
+
+	// Long runs of holidays are still skipped.
+	closed := BusinessCalendar{Holidays: HolidayFunc(func(g *Goment) bool { return g.Year() == 2024 && g.Month() <= 10 })}
+	assert.Equal("2024-11-01", simpleString("2023-12-29").AddBusinessDays(1, closed).Format("YYYY-MM-DD"))
+	assert.Equal("2023-12-29", simpleString("2024-11-01").PrevBusinessDay(closed).Format("YYYY-MM-DD"))
+}
+
+func TestAddBusinessDaysKeepsTimeAcrossDST(t *testing.T) {
+	assert := assert.New(t)
+
+	g := simpleTime(time.Date(2024, 3, 8, 9, 0, 0, 0, chicagoLocation())).AddBusinessDays(1)
+	assert.Equal("2024-03-11T09:00:00-05:00", g.Format())
+}
+
+func TestIsBusinessDay(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(simpleString("2024-01-05").IsBusinessDay())
+	assert.False(simpleString("2024-01-06").IsBusinessDay())
+	assert.False(simpleString("2024-01-07").IsBusinessDay())
+	assert.True(simpleString("2024-01-08").IsBusinessDay())
+}
+
+func TestNextAndPrevBusinessDay(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("2024-01-08", simpleString("2024-01-05").NextBusinessDay().Format("YYYY-MM-DD"))
+	assert.Equal("2024-01-08", simpleString("2024-01-06").NextBusinessDay().Format("YYYY-MM-DD"))
+	assert.Equal("2024-01-05", simpleString("2024-01-08").PrevBusinessDay().Format("YYYY-MM-DD"))
+	assert.Equal("2024-01-05", simpleString("2024-01-07").PrevBusinessDay().Format("YYYY-MM-DD"))
+}
+
+func TestBusinessDiff(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(1, simpleString("2024-01-08").BusinessDiff("2024-01-05"))
+	assert.Equal(-1, simpleString("2024-01-05").BusinessDiff("2024-01-08"))
+	assert.Equal(0, simpleString("2024-01-05").BusinessDiff("2024-01-05T18:00:00Z"))
+	assert.Equal(0, simpleString("2024-01-07").BusinessDiff("2024-01-06"))
+	assert.Equal(22, simpleString("2024-01-31").BusinessDiff("2024-01-01"))
+	assert.Equal(261, simpleString("2024-12-31").BusinessDiff("2024-01-01"))
+	assert.Equal(0, simpleString("2024-01-31").BusinessDiff("not a date"))
+
+	holidays, _ := NewHolidayDates("2024-01-01", "2024-01-15")
+	assert.Equal(21, simpleString("2024-01-31").BusinessDiff("2023-12-31", BusinessCalendar{Holidays: holidays}))
+	assert.Equal(-20, simpleString("2023-12-31").BusinessDiff("2024-01-31", BusinessCalendar{Holidays: holidays}))
+}
+
+func TestSetBusinessCalendar(t *testing.T) {
+	assert := assert.New(t)
+	defer SetBusinessCalendar(BusinessCalendar{})
+
+	holidays, _ := NewHolidayDates("2024-01-08")
+	SetBusinessCalendar(BusinessCalendar{Holidays: holidays})
+
+	assert.Equal("2024-01-09", simpleString("2024-01-05").NextBusinessDay().Format("YYYY-MM-DD"))
+	assert.Equal("2024-01-08", simpleString("2024-01-05").NextBusinessDay(BusinessCalendar{}).Format("YYYY-MM-DD"))
+}
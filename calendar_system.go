@@ -0,0 +1,146 @@
+package goment
+
+import "time"
+
+// calendarSystem is a calendar with twelve months a year, like the Gregorian, Jalali & Hijri calendars.
+type calendarSystem interface {
+	// name returns the name of the calendar, like Jalali.
+	name() string
+	// date returns the year, month & day of the date of a time, and false if the date is not supported.
+	date(t time.Time) (int, int, int, bool)
+	// time returns the date of a year, month & day at midnight UTC, and false if the year is not supported. Months &
+	// days outside their ranges overflow into the next or previous year & month.
+	time(year, month, day int) (time.Time, bool)
+	// daysInMonth returns the number of days in a month.
+	daysInMonth(year, month int) int
+}
+
+type gregorianCalendar struct{}
+
+var gregorian calendarSystem = gregorianCalendar{}
+
+func (gregorianCalendar) name() string {
+	return "Gregorian"
+}
+
+func (gregorianCalendar) date(t time.Time) (int, int, int, bool) {
+	year, month, day := t.Date()
+	return year, int(month), day, true
+}
+
+func (gregorianCalendar) time(year, month, day int) (time.Time, bool) {
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC), true
+}
+
+func (gregorianCalendar) daysInMonth(year, month int) int {
+	return daysInMonth(month, year)
+}
+
+// calendarDayOfYear returns the day of the year of a time in the calendar, or 0 if the date is not supported.
+func calendarDayOfYear(calendar calendarSystem, t time.Time) int {
+	year, _, _, ok := calendar.date(t)
+	if !ok {
+		return 0
+	}
+
+	start, ok := calendar.time(year, 1, 1)
+	if !ok {
+		return 0
+	}
+	return civilDay(t) - civilDay(start) + 1
+}
+
+// calendarDaysInYear returns the number of days in a year of the calendar, or 0 if the year is not supported.
+func calendarDaysInYear(calendar calendarSystem, year int) int {
+	start, ok := calendar.time(year, 1, 1)
+	end, endOk := calendar.time(year+1, 1, 1)
+	if !ok || !endOk {
+		return 0
+	}
+	return civilDay(end) - civilDay(start)
+}
+
+// addCalendarMonthsPinned adds months of the calendar to a time. If the new month has less days, the date is pinned to
+// the end of the month. The time is unchanged if the date is not supported.
+func addCalendarMonthsPinned(calendar calendarSystem, t time.Time, months int) time.Time {
+	year, month, day, ok := calendar.date(t)
+	if !ok {
+		return t
+	}
+
+	year += floorDiv(month-1+months, 12)
+	month = month + months - floorDiv(month-1+months, 12)*12
+	if last := calendar.daysInMonth(year, month); day > last {
+		day = last
+	}
+
+	date, ok := calendar.time(year, month, day)
+	if !ok {
+		return t
+	}
+	return time.Date(date.Year(), date.Month(), date.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
+
+// normalizeMonth moves a month outside 1 to 12 into the next or previous years.
+func normalizeMonth(year, month int) (int, int) {
+	years := floorDiv(month-1, 12)
+	return year + years, month - years*12
+}
+
+// floorDiv divides two integers, rounding towards negative infinity.
+func floorDiv(a, b int) int {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
+
+func (g *Goment) calendarDate(calendar calendarSystem) (int, int, int) {
+	year, month, day, _ := calendar.date(g.ToTime())
+	return year, month, day
+}
+
+func (g *Goment) addCalendarMonths(calendar calendarSystem, months int) *Goment {
+	g.time = addCalendarMonthsPinned(calendar, g.ToTime(), months)
+	return g
+}
+
+// setCalendarDate sets the day of the month in the calendar, pinning it to the end of the month.
+func (g *Goment) setCalendarDate(calendar calendarSystem, date int) *Goment {
+	year, month, day, ok := calendar.date(g.ToTime())
+	if !ok || date < 1 || date > 31 {
+		return g
+	}
+
+	if last := calendar.daysInMonth(year, month); date > last {
+		date = last
+	}
+	return g.addDays(date - day)
+}
+
+// setCalendarMonth sets the month of the year in the calendar, pinning the date to the end of the month.
+func (g *Goment) setCalendarMonth(calendar calendarSystem, month int) *Goment {
+	_, current, _, ok := calendar.date(g.ToTime())
+	if !ok || month < 1 || month > 12 {
+		return g
+	}
+	return g.addCalendarMonths(calendar, month-current)
+}
+
+func (g *Goment) startOfCalendarYear(calendar calendarSystem) *Goment {
+	return g.setCalendarMonth(calendar, 1).startOfCalendarMonth(calendar)
+}
+
+func (g *Goment) startOfCalendarMonth(calendar calendarSystem) *Goment {
+	return g.setCalendarDate(calendar, 1).startOfDay()
+}
+
+func (g *Goment) endOfCalendarYear(calendar calendarSystem) *Goment {
+	return g.setCalendarMonth(calendar, 12).endOfCalendarMonth(calendar)
+}
+
+func (g *Goment) endOfCalendarMonth(calendar calendarSystem) *Goment {
+	year, month, _ := g.calendarDate(calendar)
+	return g.setCalendarDate(calendar, calendar.daysInMonth(year, month)).endOfDay()
+}
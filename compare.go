@@ -1,6 +1,7 @@
 package goment
 
 import (
+	"errors"
 	"regexp"
 )
 
@@ -26,13 +27,30 @@ func (g *Goment) IsBefore(args ...interface{}) bool {
 		return g.ToTime().Before(input.ToTime())
 	}
 
-	if units, ok := args[1].(string); ok {
-		return g.ToTime().Before(input.StartOf(units).ToTime())
+	if isUnitArg(args[1]) {
+		return g.ToTime().Before(input.StartOfUnit(unitFromArg(args[1])).ToTime())
 	}
 
 	return false
 }
 
+// IsBeforeE will check if a Goment is before another Goment, like IsBefore. An error is returned if the Goment to compare
+// to can't be created or the units are not supported.
+func (g *Goment) IsBeforeE(args ...interface{}) (bool, error) {
+	if len(args) > 2 {
+		return false, errors.New("Invalid number of arguments")
+	}
+	if err := validateCompareArgs(args, 1); err != nil {
+		return false, err
+	}
+	return g.IsBefore(args...), nil
+}
+
+// IsBeforeUnit will check if a Goment is before the start of the unit containing another Goment.
+func (g *Goment) IsBeforeUnit(input interface{}, unit Unit) bool {
+	return g.IsBefore(input, unit)
+}
+
 // IsAfter will check if a Goment is after another Goment.
 func (g *Goment) IsAfter(args ...interface{}) bool {
 	var err error
@@ -53,13 +71,30 @@ func (g *Goment) IsAfter(args ...interface{}) bool {
 		return g.ToTime().After(input.ToTime())
 	}
 
-	if units, ok := args[1].(string); ok {
-		return g.ToTime().After(input.EndOf(units).ToTime())
+	if isUnitArg(args[1]) {
+		return g.ToTime().After(input.EndOfUnit(unitFromArg(args[1])).ToTime())
 	}
 
 	return false
 }
 
+// IsAfterE will check if a Goment is after another Goment, like IsAfter. An error is returned if the Goment to compare
+// to can't be created or the units are not supported.
+func (g *Goment) IsAfterE(args ...interface{}) (bool, error) {
+	if len(args) > 2 {
+		return false, errors.New("Invalid number of arguments")
+	}
+	if err := validateCompareArgs(args, 1); err != nil {
+		return false, err
+	}
+	return g.IsAfter(args...), nil
+}
+
+// IsAfterUnit will check if a Goment is after the end of the unit containing another Goment.
+func (g *Goment) IsAfterUnit(input interface{}, unit Unit) bool {
+	return g.IsAfter(input, unit)
+}
+
 // IsSame will check if a Goment is the same as another Goment.
 func (g *Goment) IsSame(args ...interface{}) bool {
 	numArgs := len(args)
@@ -73,28 +108,80 @@ func (g *Goment) IsSame(args ...interface{}) bool {
 			return g.ToTime().Equal(input.ToTime())
 		}
 
-		if units, ok := args[1].(string); ok {
-			return g.StartOf(units).ToTime().Equal(input.StartOf(units).ToTime())
+		if isUnitArg(args[1]) {
+			unit := unitFromArg(args[1])
+			return g.Clone().StartOfUnit(unit).ToTime().Equal(input.StartOfUnit(unit).ToTime())
 		}
 	}
 	return false
 }
 
+// IsSameE will check if a Goment is the same as another Goment, like IsSame. An error is returned if the Goment to compare
+// to can't be created or the units are not supported.
+func (g *Goment) IsSameE(args ...interface{}) (bool, error) {
+	if len(args) > 2 {
+		return false, errors.New("Invalid number of arguments")
+	}
+	if err := validateCompareArgs(args, 1); err != nil {
+		return false, err
+	}
+	return g.IsSame(args...), nil
+}
+
+// IsSameUnit will check if a Goment is in the same unit as another Goment.
+func (g *Goment) IsSameUnit(input interface{}, unit Unit) bool {
+	return g.IsSame(input, unit)
+}
+
 // IsSameOrBefore will check if a Goment is before or the same as another Goment.
 func (g *Goment) IsSameOrBefore(args ...interface{}) bool {
 	return g.IsSame(args...) || g.IsBefore(args...)
 }
 
+// IsSameOrBeforeE will check if a Goment is before or the same as another Goment, like IsSameOrBefore. An error is returned if the Goment to compare
+// to can't be created or the units are not supported.
+func (g *Goment) IsSameOrBeforeE(args ...interface{}) (bool, error) {
+	if len(args) > 2 {
+		return false, errors.New("Invalid number of arguments")
+	}
+	if err := validateCompareArgs(args, 1); err != nil {
+		return false, err
+	}
+	return g.IsSameOrBefore(args...), nil
+}
+
+// IsSameOrBeforeUnit will check if a Goment is before or in the same unit as another Goment.
+func (g *Goment) IsSameOrBeforeUnit(input interface{}, unit Unit) bool {
+	return g.IsSameOrBefore(input, unit)
+}
+
 // IsSameOrAfter will check if a Goment is after or the same as another Goment.
 func (g *Goment) IsSameOrAfter(args ...interface{}) bool {
 	return g.IsSame(args...) || g.IsAfter(args...)
 }
 
+// IsSameOrAfterE will check if a Goment is after or the same as another Goment, like IsSameOrAfter. An error is returned if the Goment to compare
+// to can't be created or the units are not supported.
+func (g *Goment) IsSameOrAfterE(args ...interface{}) (bool, error) {
+	if len(args) > 2 {
+		return false, errors.New("Invalid number of arguments")
+	}
+	if err := validateCompareArgs(args, 1); err != nil {
+		return false, err
+	}
+	return g.IsSameOrAfter(args...), nil
+}
+
+// IsSameOrAfterUnit will check if a Goment is after or in the same unit as another Goment.
+func (g *Goment) IsSameOrAfterUnit(input interface{}, unit Unit) bool {
+	return g.IsSameOrAfter(input, unit)
+}
+
 // IsBetween will check if a Goment is between two other Goments.
 func (g *Goment) IsBetween(args ...interface{}) bool {
 	numArgs := len(args)
 	if numArgs >= 2 {
-		units := ""
+		var units interface{} = ""
 		inclusivity := "()"
 		fromResult, toResult := false, false
 
@@ -109,8 +196,8 @@ func (g *Goment) IsBetween(args ...interface{}) bool {
 		}
 
 		if numArgs >= 3 {
-			if parsedUnits, ok := args[2].(string); ok {
-				units = parsedUnits
+			if isUnitArg(args[2]) {
+				units = args[2]
 			}
 		}
 
@@ -139,3 +226,44 @@ func (g *Goment) IsBetween(args ...interface{}) bool {
 
 	return false
 }
+
+// IsBetweenE will check if a Goment is between two other Goments, like IsBetween. An error is returned if the Goments
+// to compare to can't be created, or the units or inclusivity are not supported.
+func (g *Goment) IsBetweenE(args ...interface{}) (bool, error) {
+	if len(args) < 2 || len(args) > 4 {
+		return false, errors.New("Invalid number of arguments")
+	}
+	if err := validateCompareArgs(args, 2); err != nil {
+		return false, err
+	}
+	if len(args) == 4 {
+		inclusivity, ok := args[3].(string)
+		if !ok || !inclusivityRegex.MatchString(inclusivity) {
+			return false, errors.New("Inclusivity must be one of (), [), (] or []")
+		}
+	}
+	return g.IsBetween(args...), nil
+}
+
+// IsBetweenUnit will check if a Goment is between two other Goments at the unit's granularity. The inclusivity is
+// one of "()", "[)", "(]" or "[]".
+func (g *Goment) IsBetweenUnit(from, to interface{}, unit Unit, inclusivity string) bool {
+	return g.IsBetween(from, to, unit, inclusivity)
+}
+
+// validateCompareArgs checks that Goments can be created from the inputs, which are the first arguments, and that the
+// units following them are supported.
+func validateCompareArgs(args []interface{}, numInputs int) error {
+	for i, arg := range args {
+		if i < numInputs {
+			if _, err := New(arg); err != nil {
+				return err
+			}
+		} else if i == numInputs {
+			if _, err := unitFromArgE(arg); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -218,6 +218,21 @@ func TestIsSameDay(t *testing.T) {
 	assert.True(t, lib.IsSame(lib, "day"), "same goments are in the same day")
 }
 
+func TestIsSameDoesNotChangeGoments(t *testing.T) {
+	assert := assert.New(t)
+
+	lib := simpleTime(time.Date(2011, 3, 3, 4, 5, 6, 7, time.UTC))
+	other := simpleTime(time.Date(2011, 3, 3, 8, 9, 10, 11, time.UTC))
+
+	assert.True(lib.IsSame(other, "day"))
+	assert.True(lib.IsSameOrBefore(other, "day"))
+	assert.True(lib.IsBetween(other, other, "day", "[]"))
+	assert.Equal("2011-03-03T04:05:06+00:00", lib.Format())
+	assert.Equal(7, lib.Nanosecond())
+	assert.Equal("2011-03-03T08:09:10+00:00", other.Format())
+	assert.Equal(11, other.Nanosecond())
+}
+
 func TestIsSameHour(t *testing.T) {
 	scenario := getTestScenario("is_same", "hour")
 
@@ -570,3 +585,65 @@ func TestIsBetweenSecondInclusivity(t *testing.T) {
 
 	runTestsForBetweenScenario(t, scenario, lib.IsBetween)
 }
+
+func TestCompareE(t *testing.T) {
+	assert := assert.New(t)
+
+	lib := simpleTime(time.Date(2011, 3, 3, 4, 5, 6, 7, time.UTC))
+	other := simpleTime(time.Date(2011, 3, 4, 4, 5, 6, 7, time.UTC))
+
+	before, err := lib.IsBeforeE(other)
+	assert.Nil(err)
+	assert.True(before)
+
+	_, err = lib.IsBeforeE("not a date")
+	assert.EqualError(err, "Not a matching ISO-8601 date")
+
+	_, err = lib.IsAfterE(other, "dya")
+	assert.EqualError(err, "Invalid unit dya")
+
+	_, err = lib.IsSameE(other, 1)
+	assert.EqualError(err, "Units must be a string or Unit")
+
+	same, err := lib.IsSameE(other, "month")
+	assert.Nil(err)
+	assert.True(same)
+
+	_, err = lib.IsSameOrBeforeE(1.5)
+	assert.EqualError(err, "Invalid argument type")
+
+	after, err := lib.IsSameOrAfterE(other, Day)
+	assert.Nil(err)
+	assert.False(after)
+
+	_, err = lib.IsSameE(other, "day", "extra")
+	assert.EqualError(err, "Invalid number of arguments")
+}
+
+func TestIsBetweenE(t *testing.T) {
+	assert := assert.New(t)
+
+	lib := simpleTime(time.Date(2011, 3, 3, 4, 5, 6, 7, time.UTC))
+	from := simpleTime(time.Date(2011, 3, 3, 0, 0, 0, 0, time.UTC))
+	to := simpleTime(time.Date(2011, 3, 4, 0, 0, 0, 0, time.UTC))
+
+	between, err := lib.IsBetweenE(from, to)
+	assert.Nil(err)
+	assert.True(between)
+
+	_, err = lib.IsBetweenE(from)
+	assert.EqualError(err, "Invalid number of arguments")
+
+	_, err = lib.IsBetweenE(from, "not a date")
+	assert.EqualError(err, "Not a matching ISO-8601 date")
+
+	_, err = lib.IsBetweenE(from, to, "dya")
+	assert.EqualError(err, "Invalid unit dya")
+
+	_, err = lib.IsBetweenE(from, to, "day", "[[")
+	assert.EqualError(err, "Inclusivity must be one of (), [), (] or []")
+
+	between, err = lib.IsBetweenE(from, to, "day", "[]")
+	assert.Nil(err)
+	assert.True(between)
+}
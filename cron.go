@@ -0,0 +1,449 @@
+package goment
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSearchYears is how far Next & Prev look for a matching time, which covers rare schedules like the 5th Monday
+// of February.
+const cronSearchYears = 100
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 0 1 1 *",
+	"@annually": "0 0 0 1 1 *",
+	"@monthly":  "0 0 0 1 * *",
+	"@weekly":   "0 0 0 * * 0",
+	"@daily":    "0 0 0 * * *",
+	"@midnight": "0 0 0 * * *",
+	"@hourly":   "0 0 * * * *",
+}
+
+var cronMonthNames = []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}
+
+var cronWeekdayNames = []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
+
+// cronBits is a set of the values of a cron field.
+type cronBits uint64
+
+func (b cronBits) has(n int) bool {
+	return b&(1<<uint(n)) != 0
+}
+
+// cronRange is the allowed values of a cron field, with the names that can be used for them.
+type cronRange struct {
+	name  string
+	min   int
+	max   int
+	names []string
+}
+
+var (
+	cronSecondRange  = cronRange{name: "second", min: 0, max: 59}
+	cronMinuteRange  = cronRange{name: "minute", min: 0, max: 59}
+	cronHourRange    = cronRange{name: "hour", min: 0, max: 23}
+	cronDayRange     = cronRange{name: "day of month", min: 1, max: 31}
+	cronMonthRange   = cronRange{name: "month", min: 1, max: 12, names: cronMonthNames}
+	cronWeekdayRange = cronRange{name: "day of week", min: 0, max: 7, names: cronWeekdayNames}
+)
+
+// cronNthWeekday is a day of week like 5#3, the third Friday of the month.
+type cronNthWeekday struct {
+	weekday int
+	n       int
+}
+
+// Cron is a cron expression, used to find the times of a schedule. It accepts 5 fields (minute, hour, day of month,
+// month & day of week), 6 fields with seconds first, or a macro like @daily.
+//
+// Fields can be lists, ranges & steps like 1,15, MON-FRI or */5. The day of month can also be L for the last day,
+// L-2 for two days before the last day, LW for the last weekday, or 15W for the weekday nearest the 15th. The day
+// of week can be 5L for the last Friday of the month, or 5#3 for the third Friday. When both the day of month & day
+// of week are restricted, a day matches if either of them matches.
+//
+// Times are matched on the wall clock of the Goment's time zone. A time skipped when the clocks go forward runs
+// later by the length of the gap, so 2:30am becomes 3:30am, and a time repeated when the clocks go back runs once,
+// the first time.
+type Cron struct {
+	expression      string
+	seconds         cronBits
+	minutes         cronBits
+	hours           cronBits
+	days            cronBits
+	months          cronBits
+	weekdays        cronBits
+	daysStar        bool
+	weekdaysStar    bool
+	lastDayOffsets  []int
+	lastWeekday     bool
+	nearestWeekdays []int
+	lastWeekdays    []int
+	nthWeekdays     []cronNthWeekday
+}
+
+// NewCron parses a cron expression.
+func NewCron(expression string) (*Cron, error) {
+	c := &Cron{expression: expression}
+
+	spec := strings.TrimSpace(expression)
+	if strings.HasPrefix(spec, "@") {
+		macro, ok := cronMacros[strings.ToLower(spec)]
+		if !ok {
+			return &Cron{}, errors.New("Invalid cron macro " + spec)
+		}
+		spec = macro
+	}
+
+	fields := strings.Fields(spec)
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return &Cron{}, errors.New("Cron expression must have 5 or 6 fields")
+	}
+
+	var err error
+	if c.seconds, err = parseCronField(fields[0], cronSecondRange); err != nil {
+		return &Cron{}, err
+	}
+	if c.minutes, err = parseCronField(fields[1], cronMinuteRange); err != nil {
+		return &Cron{}, err
+	}
+	if c.hours, err = parseCronField(fields[2], cronHourRange); err != nil {
+		return &Cron{}, err
+	}
+	if err = c.parseDays(fields[3]); err != nil {
+		return &Cron{}, err
+	}
+	if c.months, err = parseCronField(fields[4], cronMonthRange); err != nil {
+		return &Cron{}, err
+	}
+	if err = c.parseWeekdays(fields[5]); err != nil {
+		return &Cron{}, err
+	}
+
+	return c, nil
+}
+
+// String returns the cron expression.
+func (c *Cron) String() string {
+	return c.expression
+}
+
+// Next returns the first time of the schedule after a date, which accepts the same arguments as New. The result is
+// in the time zone of the date. Nil is returned if the date is not valid or the schedule has no more times.
+func (c *Cron) Next(date interface{}) *Goment {
+	g, err := New(date)
+	if err != nil {
+		return nil
+	}
+
+	t := g.ToTime()
+	loc := t.Location()
+	wall := wallClockOf(t).Add(time.Second)
+	limit := wall.AddDate(cronSearchYears, 0, 0)
+
+	for {
+		w, ok := c.nextWall(wall, limit)
+		if !ok {
+			return nil
+		}
+
+		// Times repeated when the clocks go back resolve to their first occurrence, which can be before the date.
+		if next := resolveWallClock(w, loc); next.After(t) {
+			g.time = next
+			return g
+		}
+		wall = w.Add(time.Second)
+	}
+}
+
+// Prev returns the last time of the schedule before a date, which accepts the same arguments as New. The result is
+// in the time zone of the date. Nil is returned if the date is not valid or the schedule has no earlier times.
+func (c *Cron) Prev(date interface{}) *Goment {
+	g, err := New(date)
+	if err != nil {
+		return nil
+	}
+
+	t := g.ToTime()
+	loc := t.Location()
+	wall := wallClockOf(t)
+	if t.Nanosecond() == 0 {
+		wall = wall.Add(-time.Second)
+	}
+
+	// When the date is the second occurrence of a repeated time, the first occurrences of the rest of the repeated
+	// times are also before it.
+	second := t.Truncate(time.Second)
+	if first := resolveWallClock(wallClockOf(t), loc); first.Before(second) {
+		wall = wall.Add(second.Sub(first))
+	}
+
+	limit := wall.AddDate(-cronSearchYears, 0, 0)
+
+	for {
+		w, ok := c.prevWall(wall, limit)
+		if !ok {
+			return nil
+		}
+
+		if prev := resolveWallClock(w, loc); prev.Before(t) {
+			g.time = prev
+			return g
+		}
+		wall = w.Add(-time.Second)
+	}
+}
+
+// nextWall returns the first wall clock time at or after a wall clock time that matches the fields. Wall clock
+// times are kept in UTC, so there are no DST changes to skip.
+func (c *Cron) nextWall(w, limit time.Time) (time.Time, bool) {
+	for !w.After(limit) {
+		year, month, day := w.Date()
+
+		switch {
+		case !c.months.has(int(month)):
+			w = time.Date(year, month+1, 1, 0, 0, 0, 0, time.UTC)
+		case !c.matchesDay(year, month, day):
+			w = time.Date(year, month, day+1, 0, 0, 0, 0, time.UTC)
+		case !c.hours.has(w.Hour()):
+			w = time.Date(year, month, day, w.Hour()+1, 0, 0, 0, time.UTC)
+		case !c.minutes.has(w.Minute()):
+			w = w.Truncate(time.Minute).Add(time.Minute)
+		case !c.seconds.has(w.Second()):
+			w = w.Add(time.Second)
+		default:
+			return w, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// prevWall returns the last wall clock time at or before a wall clock time that matches the fields.
+func (c *Cron) prevWall(w, limit time.Time) (time.Time, bool) {
+	for !w.Before(limit) {
+		year, month, day := w.Date()
+
+		switch {
+		case !c.months.has(int(month)):
+			w = time.Date(year, month, 1, 0, 0, 0, 0, time.UTC).Add(-time.Second)
+		case !c.matchesDay(year, month, day):
+			w = time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Add(-time.Second)
+		case !c.hours.has(w.Hour()):
+			w = time.Date(year, month, day, w.Hour(), 0, 0, 0, time.UTC).Add(-time.Second)
+		case !c.minutes.has(w.Minute()):
+			w = w.Truncate(time.Minute).Add(-time.Second)
+		case !c.seconds.has(w.Second()):
+			w = w.Add(-time.Second)
+		default:
+			return w, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func (c *Cron) matchesDay(year int, month time.Month, day int) bool {
+	last := daysInMonth(int(month), year)
+	weekday := int(time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Weekday())
+
+	dayMatches := c.days.has(day)
+	for _, offset := range c.lastDayOffsets {
+		dayMatches = dayMatches || day == last-offset
+	}
+	if c.lastWeekday {
+		dayMatches = dayMatches || day == nearestWeekday(year, month, last)
+	}
+	for _, n := range c.nearestWeekdays {
+		dayMatches = dayMatches || (n <= last && day == nearestWeekday(year, month, n))
+	}
+
+	weekdayMatches := c.weekdays.has(weekday)
+	for _, w := range c.lastWeekdays {
+		weekdayMatches = weekdayMatches || (w == weekday && day+7 > last)
+	}
+	for _, nth := range c.nthWeekdays {
+		weekdayMatches = weekdayMatches || (nth.weekday == weekday && (day-1)/7+1 == nth.n)
+	}
+
+	if c.daysStar || c.weekdaysStar {
+		return dayMatches && weekdayMatches
+	}
+	return dayMatches || weekdayMatches
+}
+
+func (c *Cron) parseDays(field string) error {
+	c.daysStar = strings.HasPrefix(field, "*") || field == "?"
+
+	plain := []string{}
+	for _, part := range strings.Split(strings.ToUpper(field), ",") {
+		switch {
+		case part == "L":
+			c.lastDayOffsets = append(c.lastDayOffsets, 0)
+		case part == "LW":
+			c.lastWeekday = true
+		case strings.HasPrefix(part, "L-"):
+			offset, err := strconv.Atoi(part[2:])
+			if err != nil || offset < 1 || offset > 30 {
+				return errors.New("Invalid cron day of month " + part)
+			}
+			c.lastDayOffsets = append(c.lastDayOffsets, offset)
+		case strings.HasSuffix(part, "W"):
+			n, err := strconv.Atoi(strings.TrimSuffix(part, "W"))
+			if err != nil || n < 1 || n > 31 {
+				return errors.New("Invalid cron day of month " + part)
+			}
+			c.nearestWeekdays = append(c.nearestWeekdays, n)
+		default:
+			plain = append(plain, part)
+		}
+	}
+
+	if len(plain) > 0 {
+		days, err := parseCronField(strings.Join(plain, ","), cronDayRange)
+		if err != nil {
+			return err
+		}
+		c.days = days
+	}
+
+	return nil
+}
+
+func (c *Cron) parseWeekdays(field string) error {
+	c.weekdaysStar = strings.HasPrefix(field, "*") || field == "?"
+
+	plain := []string{}
+	for _, part := range strings.Split(strings.ToUpper(field), ",") {
+		switch {
+		case part == "L":
+			// L on its own is the last day of the week.
+			c.weekdays |= 1 << uint(time.Saturday)
+		case strings.HasSuffix(part, "L"):
+			weekday, err := parseCronValue(strings.TrimSuffix(part, "L"), cronWeekdayRange)
+			if err != nil {
+				return errors.New("Invalid cron day of week " + part)
+			}
+			c.lastWeekdays = append(c.lastWeekdays, weekday%7)
+		case strings.Contains(part, "#"):
+			weekdayN := strings.SplitN(part, "#", 2)
+			weekday, err := parseCronValue(weekdayN[0], cronWeekdayRange)
+			n, nErr := strconv.Atoi(weekdayN[1])
+			if err != nil || nErr != nil || n < 1 || n > 5 {
+				return errors.New("Invalid cron day of week " + part)
+			}
+			c.nthWeekdays = append(c.nthWeekdays, cronNthWeekday{weekday: weekday % 7, n: n})
+		default:
+			plain = append(plain, part)
+		}
+	}
+
+	if len(plain) > 0 {
+		weekdays, err := parseCronField(strings.Join(plain, ","), cronWeekdayRange)
+		if err != nil {
+			return err
+		}
+		// 7 is also Sunday.
+		if weekdays.has(7) {
+			weekdays |= 1
+		}
+		c.weekdays |= weekdays
+	}
+
+	return nil
+}
+
+// parseCronField parses a field of lists, ranges & steps, like 1,15, MON-FRI or */5.
+func parseCronField(field string, r cronRange) (cronBits, error) {
+	var bits cronBits
+
+	for _, part := range strings.Split(strings.ToUpper(field), ",") {
+		invalid := errors.New("Invalid cron " + r.name + " " + part)
+
+		rangeStep := strings.SplitN(part, "/", 2)
+		step := 1
+		if len(rangeStep) == 2 {
+			var err error
+			if step, err = strconv.Atoi(rangeStep[1]); err != nil || step < 1 {
+				return 0, invalid
+			}
+		}
+
+		start, end := r.min, r.max
+		switch base := rangeStep[0]; {
+		case base == "*" || (base == "?" && (r.name == cronDayRange.name || r.name == cronWeekdayRange.name)):
+			if r.name == cronWeekdayRange.name {
+				end = 6
+			}
+		case strings.Contains(base, "-"):
+			startEnd := strings.SplitN(base, "-", 2)
+			var startErr, endErr error
+			start, startErr = parseCronValue(startEnd[0], r)
+			end, endErr = parseCronValue(startEnd[1], r)
+			if startErr != nil || endErr != nil || start > end {
+				return 0, invalid
+			}
+		default:
+			var err error
+			if start, err = parseCronValue(base, r); err != nil {
+				return 0, invalid
+			}
+			if len(rangeStep) == 1 {
+				end = start
+			}
+		}
+
+		for n := start; n <= end; n += step {
+			bits |= 1 << uint(n)
+		}
+	}
+
+	return bits, nil
+}
+
+// parseCronValue parses a number or name in a cron field.
+func parseCronValue(value string, r cronRange) (int, error) {
+	for i, name := range r.names {
+		if value == name {
+			return i + r.min, nil
+		}
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil || n < r.min || n > r.max {
+		return 0, errors.New("Invalid cron " + r.name + " " + value)
+	}
+	return n, nil
+}
+
+// nearestWeekday returns the weekday nearest a day of the month, without leaving the month.
+func nearestWeekday(year int, month time.Month, day int) int {
+	last := daysInMonth(int(month), year)
+
+	switch time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Weekday() {
+	case time.Saturday:
+		if day == 1 {
+			return day + 2
+		}
+		return day - 1
+	case time.Sunday:
+		if day == last {
+			return day - 2
+		}
+		return day + 1
+	}
+	return day
+}
+
+// wallClockOf returns the wall clock time of a time, in UTC, without its nanoseconds.
+func wallClockOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, time.UTC)
+}
+
+// resolveWallClock returns the time in a location for a wall clock time kept in UTC.
+func resolveWallClock(w time.Time, loc *time.Location) time.Time {
+	return wallClockTime(w.Year(), w.Month(), w.Day(), w.Hour(), w.Minute(), w.Second(), 0, loc)
+}
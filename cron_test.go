@@ -0,0 +1,269 @@
+package goment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func simpleCron(expression string) *Cron {
+	c, err := NewCron(expression)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// cronTimes returns the next times of a schedule after a date.
+func cronTimes(c *Cron, date interface{}, count int) []string {
+	times := []string{}
+	g, _ := New(date)
+	for i := 0; i < count; i++ {
+		g = c.Next(g)
+		if g == nil {
+			break
+		}
+		times = append(times, g.Format())
+	}
+	return times
+}
+
+func TestNewCron(t *testing.T) {
+	assert := assert.New(t)
+
+	valid := []string{
+		"* * * * *",
+		"0 * * * * *",
+		"*/15 9-17 * * MON-FRI",
+		"0 0 1,15 * ?",
+		"0 12 L * *",
+		"0 12 L-3 * *",
+		"0 12 LW * *",
+		"0 12 15W * *",
+		"0 12 ? * 5L",
+		"0 12 ? * FRI#3",
+		"0 0 * JAN-MAR,DEC 0,7",
+		"@daily",
+		"@WEEKLY",
+	}
+	for _, expression := range valid {
+		c, err := NewCron(expression)
+		assert.Nil(err, expression)
+		assert.Equal(expression, c.String())
+	}
+
+	invalid := map[string]string{
+		"* * * *":         "Cron expression must have 5 or 6 fields",
+		"* * * * * * *":   "Cron expression must have 5 or 6 fields",
+		"@fortnightly":    "Invalid cron macro @fortnightly",
+		"60 * * * *":      "Invalid cron minute 60",
+		"* 24 * * *":      "Invalid cron hour 24",
+		"* * 0 * *":       "Invalid cron day of month 0",
+		"* * * 13 *":      "Invalid cron month 13",
+		"* * * * 8":       "Invalid cron day of week 8",
+		"61 * * * * *":    "Invalid cron second 61",
+		"* 17-9 * * *":    "Invalid cron hour 17-9",
+		"*/0 * * * *":     "Invalid cron minute */0",
+		"? * * * *":       "Invalid cron minute ?",
+		"* * * FOO *":     "Invalid cron month FOO",
+		"* * 32W * *":     "Invalid cron day of month 32W",
+		"* * L-31 * *":    "Invalid cron day of month L-31",
+		"* * * * FRI#6":   "Invalid cron day of week FRI#6",
+		"* * * * FUNL":    "Invalid cron day of week FUNL",
+		"* * 1,,2 * *":    "Invalid cron day of month ",
+		"0 0 0 * * MON-X": "Invalid cron day of week MON-X",
+	}
+	for expression, message := range invalid {
+		_, err := NewCron(expression)
+		assert.EqualError(err, message, expression)
+	}
+}
+
+func TestCronNext(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal([]string{
+		"2024-01-01T09:00:00+00:00",
+		"2024-01-01T09:15:00+00:00",
+		"2024-01-01T09:30:00+00:00",
+	}, cronTimes(simpleCron("*/15 9-17 * * MON-FRI"), "2024-01-01T08:59:59Z", 3))
+
+	assert.Equal([]string{
+		"2024-01-05T17:45:00+00:00",
+		"2024-01-08T09:00:00+00:00",
+	}, cronTimes(simpleCron("*/15 9-17 * * MON-FRI"), "2024-01-05T17:30:00Z", 2))
+
+	assert.Equal([]string{
+		"2024-01-01T00:00:10+00:00",
+		"2024-01-01T00:00:40+00:00",
+		"2024-01-01T00:01:10+00:00",
+	}, cronTimes(simpleCron("10/30 * * * * *"), "2024-01-01T00:00:00Z", 3))
+
+	// The date itself is never returned.
+	assert.Equal("2024-01-02T00:00:00+00:00", simpleCron("@daily").Next("2024-01-01T00:00:00Z").Format())
+	assert.Equal("2024-01-01T00:00:01+00:00", simpleCron("* * * * * *").Next("2024-01-01T00:00:00.5Z").Format())
+
+	// February 30th never happens.
+	assert.Nil(simpleCron("0 0 30 2 *").Next("2024-01-01T00:00:00Z"))
+	assert.Nil(simpleCron("@daily").Next("not a date"))
+}
+
+func TestCronPrev(t *testing.T) {
+	assert := assert.New(t)
+
+	c := simpleCron("*/15 9-17 * * MON-FRI")
+	assert.Equal("2024-01-05T17:45:00+00:00", c.Prev("2024-01-08T09:00:00Z").Format())
+	assert.Equal("2024-01-08T09:00:00+00:00", c.Prev("2024-01-08T09:00:00.5Z").Format())
+	assert.Equal("2023-12-31T00:00:00+00:00", simpleCron("@weekly").Prev("2024-01-06T12:00:00Z").Format())
+	assert.Equal("2024-01-01T00:00:00+00:00", simpleCron("@yearly").Prev("2024-12-31T00:00:00Z").Format())
+
+	assert.Nil(simpleCron("0 0 30 2 *").Prev("2024-01-01T00:00:00Z"))
+	assert.Nil(simpleCron("@daily").Prev("not a date"))
+}
+
+func TestCronMacros(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := map[string]string{
+		"@yearly":   "2025-01-01T00:00:00+00:00",
+		"@annually": "2025-01-01T00:00:00+00:00",
+		"@monthly":  "2024-03-01T00:00:00+00:00",
+		"@weekly":   "2024-02-18T00:00:00+00:00",
+		"@daily":    "2024-02-16T00:00:00+00:00",
+		"@midnight": "2024-02-16T00:00:00+00:00",
+		"@hourly":   "2024-02-15T11:00:00+00:00",
+	}
+
+	for macro, next := range tests {
+		assert.Equal(next, simpleCron(macro).Next("2024-02-15T10:30:00Z").Format(), macro)
+	}
+}
+
+func TestCronLastAndNearestWeekday(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal([]string{
+		"2024-01-31T12:00:00+00:00",
+		"2024-02-29T12:00:00+00:00",
+		"2024-03-31T12:00:00+00:00",
+	}, cronTimes(simpleCron("0 12 L * *"), "2024-01-01T00:00:00Z", 3))
+
+	assert.Equal([]string{
+		"2024-01-29T12:00:00+00:00",
+		"2024-02-27T12:00:00+00:00",
+	}, cronTimes(simpleCron("0 12 L-2 * *"), "2024-01-01T00:00:00Z", 2))
+
+	// The last day of March 2024 is a Sunday, & of August 2024 is a Saturday.
+	assert.Equal("2024-03-29T12:00:00+00:00", simpleCron("0 12 LW 3 *").Next("2024-01-01T00:00:00Z").Format())
+	assert.Equal("2024-08-30T12:00:00+00:00", simpleCron("0 12 LW 8 *").Next("2024-01-01T00:00:00Z").Format())
+
+	// The 15th of June 2024 is a Saturday, & of September 2024 is a Sunday.
+	assert.Equal("2024-06-14T12:00:00+00:00", simpleCron("0 12 15W 6 *").Next("2024-01-01T00:00:00Z").Format())
+	assert.Equal("2024-09-16T12:00:00+00:00", simpleCron("0 12 15W 9 *").Next("2024-01-01T00:00:00Z").Format())
+
+	// The nearest weekday does not leave the month. The 1st of June 2024 is a Saturday & the 30th is a Sunday.
+	assert.Equal("2024-06-03T12:00:00+00:00", simpleCron("0 12 1W 6 *").Next("2024-01-01T00:00:00Z").Format())
+	assert.Equal("2024-06-28T12:00:00+00:00", simpleCron("0 12 30W 6 *").Next("2024-01-01T00:00:00Z").Format())
+
+	// Months without the day are skipped.
+	assert.Equal("2024-03-29T12:00:00+00:00", simpleCron("0 12 31W * *").Next("2024-02-01T00:00:00Z").Format())
+}
+
+func TestCronNthAndLastWeekday(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal([]string{
+		"2024-01-19T12:00:00+00:00",
+		"2024-02-16T12:00:00+00:00",
+		"2024-03-15T12:00:00+00:00",
+	}, cronTimes(simpleCron("0 12 ? * FRI#3"), "2024-01-01T00:00:00Z", 3))
+
+	assert.Equal([]string{
+		"2024-01-26T12:00:00+00:00",
+		"2024-02-23T12:00:00+00:00",
+		"2024-03-29T12:00:00+00:00",
+	}, cronTimes(simpleCron("0 12 * * 5L"), "2024-01-01T00:00:00Z", 3))
+
+	// Only some months have a 5th Thursday.
+	assert.Equal([]string{
+		"2024-02-29T12:00:00+00:00",
+		"2024-05-30T12:00:00+00:00",
+	}, cronTimes(simpleCron("0 12 * * 4#5"), "2024-01-01T00:00:00Z", 2))
+
+	// L on its own is Saturday, & 7 is Sunday.
+	assert.Equal("2024-01-06T00:00:00+00:00", simpleCron("0 0 * * L").Next("2024-01-01T00:00:00Z").Format())
+	assert.Equal("2024-01-07T00:00:00+00:00", simpleCron("0 0 * * 7").Next("2024-01-01T00:00:00Z").Format())
+}
+
+func TestCronDayOfMonthOrDayOfWeek(t *testing.T) {
+	assert := assert.New(t)
+
+	// When both days are restricted, either of them can match.
+	assert.Equal([]string{
+		"2024-01-01T00:00:00+00:00",
+		"2024-01-05T00:00:00+00:00",
+		"2024-01-12T00:00:00+00:00",
+		"2024-01-13T00:00:00+00:00",
+	}, cronTimes(simpleCron("0 0 1,13 * FRI"), "2023-12-31T12:00:00Z", 4))
+
+	// When either day is *, both have to match.
+	assert.Equal([]string{
+		"2024-01-05T00:00:00+00:00",
+		"2024-01-19T00:00:00+00:00",
+	}, cronTimes(simpleCron("0 0 */2 * FRI"), "2024-01-01T00:00:00Z", 2))
+}
+
+func TestCronKeepsZone(t *testing.T) {
+	assert := assert.New(t)
+
+	g := simpleTime(time.Date(2024, 1, 1, 12, 0, 0, 0, chicagoLocation()))
+	g.SetLocale("es")
+	next := simpleCron("0 9 * * *").Next(g)
+
+	assert.Equal("2024-01-02T09:00:00-06:00", next.Format())
+	assert.Equal("es", next.Locale())
+	assert.Equal("2024-01-01T12:00:00-06:00", g.Format())
+}
+
+func TestCronAcrossDST(t *testing.T) {
+	assert := assert.New(t)
+
+	chicago := chicagoLocation()
+
+	// On 2024-03-10 the clocks skip from 2am to 3am, so 2:30am runs at 3:30am.
+	assert.Equal([]string{
+		"2024-03-09T02:30:00-06:00",
+		"2024-03-10T03:30:00-05:00",
+		"2024-03-11T02:30:00-05:00",
+	}, cronTimes(simpleCron("30 2 * * *"), time.Date(2024, 3, 9, 0, 0, 0, 0, chicago), 3))
+
+	assert.Equal([]string{
+		"2024-03-10T01:30:00-06:00",
+		"2024-03-10T03:00:00-05:00",
+		"2024-03-10T03:30:00-05:00",
+		"2024-03-10T04:00:00-05:00",
+	}, cronTimes(simpleCron("0,30 * * * *"), time.Date(2024, 3, 10, 1, 0, 0, 0, chicago), 4))
+
+	// On 2024-11-03 the clocks go back from 2am to 1am, so 1:30am runs once.
+	assert.Equal([]string{
+		"2024-11-02T01:30:00-05:00",
+		"2024-11-03T01:30:00-05:00",
+		"2024-11-04T01:30:00-06:00",
+	}, cronTimes(simpleCron("30 1 * * *"), time.Date(2024, 11, 2, 0, 0, 0, 0, chicago), 3))
+
+	assert.Equal([]string{
+		"2024-11-03T01:00:00-05:00",
+		"2024-11-03T01:30:00-05:00",
+		"2024-11-03T02:00:00-06:00",
+	}, cronTimes(simpleCron("0,30 * * * *"), time.Date(2024, 11, 3, 0, 45, 0, 0, chicago), 3))
+
+	// The hour that is repeated is not run again.
+	secondPass := time.Date(2024, 11, 3, 1, 15, 0, 0, chicago).Add(time.Hour)
+	assert.Equal("2024-11-03T01:15:00-06:00", simpleTime(secondPass).Format())
+	assert.Equal("2024-11-03T02:00:00-06:00", simpleCron("0,30 * * * *").Next(secondPass).Format())
+	assert.Equal("2024-11-03T01:30:00-05:00", simpleCron("0,30 * * * *").Prev(secondPass).Format())
+
+	assert.Equal("2024-03-10T01:30:00-06:00", simpleCron("0,30 * * * *").Prev(time.Date(2024, 3, 10, 3, 0, 0, 0, chicago)).Format())
+	assert.Equal("2024-03-10T03:30:00-05:00", simpleCron("30 2 * * *").Prev(time.Date(2024, 3, 10, 4, 0, 0, 0, chicago)).Format())
+}
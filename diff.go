@@ -10,68 +10,160 @@ type diff struct {
 	End   *Goment
 }
 
+// newDiff creates a diff from a Goment to another, which is compared in the time zone of the Goment.
+func newDiff(g, other *Goment) diff {
+	end := other.Clone()
+	end.time = end.ToTime().In(g.ToTime().Location())
+
+	return diff{
+		Start: g,
+		End:   end,
+	}
+}
+
 // InYears returns the duration in number of years.
-func (d diff) InYears() int {
-	return d.monthDiff() / 12
+func (d diff) InYears() float64 {
+	return d.monthDiff(gregorian) / 12
+}
+
+// InQuarters returns the duration in number of quarters.
+func (d diff) InQuarters() float64 {
+	return d.monthDiff(gregorian) / 3
 }
 
 // InMonths returns the duration in number of months.
-func (d diff) InMonths() int {
-	return d.monthDiff()
+func (d diff) InMonths() float64 {
+	return d.monthDiff(gregorian)
+}
+
+// InJYears returns the duration in number of Jalali years.
+func (d diff) InJYears() float64 {
+	return d.monthDiff(jalali) / 12
+}
+
+// InJMonths returns the duration in number of Jalali months.
+func (d diff) InJMonths() float64 {
+	return d.monthDiff(jalali)
+}
+
+// InIYears returns the duration in number of Hijri years.
+func (d diff) InIYears() float64 {
+	return d.monthDiff(d.Start.hijri) / 12
+}
+
+// InIMonths returns the duration in number of Hijri months.
+func (d diff) InIMonths() float64 {
+	return d.monthDiff(d.Start.hijri)
 }
 
 // InWeeks returns the duration in number of weeks.
-func (d diff) InWeeks() int {
-	return absFloor(math.Floor(float64(d.InDays() / 7)))
+func (d diff) InWeeks() float64 {
+	return d.InDays() / 7
 }
 
-// InDays returns the duration in number of days.
-func (d diff) InDays() int {
-	return absFloor(math.Floor(float64(d.InHours()) / 24))
+// InDays returns the duration in number of days. A day that is shorter or longer because of a daylight saving
+// transition still counts as one day.
+func (d diff) InDays() float64 {
+	_, startOffset := d.Start.ToTime().Zone()
+	_, endOffset := d.End.ToTime().Zone()
+	zoneDelta := time.Duration(endOffset-startOffset) * time.Second
+
+	return float64(d.subtract()-zoneDelta) / float64(24*time.Hour)
 }
 
 // InHours returns the duration in number of hours.
-func (d diff) InHours() int {
-	return absFloor(d.subtract().Hours())
+func (d diff) InHours() float64 {
+	return d.subtract().Hours()
 }
 
 // InMinutes returns the duration in number of minutes.
-func (d diff) InMinutes() int {
-	return absFloor(d.subtract().Minutes())
+func (d diff) InMinutes() float64 {
+	return d.subtract().Minutes()
 }
 
 // InSeconds returns the duration in number of seconds.
-func (d diff) InSeconds() int {
-	return absFloor(d.subtract().Seconds())
+func (d diff) InSeconds() float64 {
+	return d.subtract().Seconds()
+}
+
+// InMilliseconds returns the duration in number of milliseconds.
+func (d diff) InMilliseconds() float64 {
+	return float64(d.subtract()) / float64(time.Millisecond)
+}
+
+// InNanoseconds returns the duration in number of nanoseconds.
+func (d diff) InNanoseconds() float64 {
+	return float64(d.subtract())
+}
+
+// In returns the duration in number of a unit, which is seconds if the unit is not supported.
+func (d diff) In(unit Unit) float64 {
+	switch unit {
+	case Year:
+		return d.InYears()
+	case Quarter:
+		return d.InQuarters()
+	case Month:
+		return d.InMonths()
+	case JalaliYear:
+		return d.InJYears()
+	case JalaliMonth:
+		return d.InJMonths()
+	case HijriYear:
+		return d.InIYears()
+	case HijriMonth:
+		return d.InIMonths()
+	case Week, ISOWeek:
+		return d.InWeeks()
+	case Day:
+		return d.InDays()
+	case Hour:
+		return d.InHours()
+	case Minute:
+		return d.InMinutes()
+	case Millisecond:
+		return d.InMilliseconds()
+	case Nanosecond:
+		return d.InNanoseconds()
+	default:
+		return d.InSeconds()
+	}
 }
 
 func (d diff) subtract() time.Duration {
 	return d.Start.ToTime().Sub(d.End.ToTime())
 }
 
-func (d diff) monthDiff() int {
-	startYear, startMonth := d.Start.Year(), d.Start.Month()
-	endYear, endMonth := d.End.Year(), d.End.Month()
+// monthDiff returns the number of months in the calendar from the end to the start. The whole months are counted, then
+// the rest is the fraction of the month that it falls in.
+func (d diff) monthDiff(calendar calendarSystem) float64 {
+	start, end := d.Start.ToTime(), d.End.ToTime()
+	startYear, startMonth, startDay, _ := calendar.date(start)
+	endYear, endMonth, endDay, _ := calendar.date(end)
 
-	wholeMonthDiff := ((endYear - startYear) * 12) + int(endMonth-startMonth)
-	anchor := d.Start.Clone().Add(wholeMonthDiff, "months")
+	// Counting from the later day of the month keeps the months symmetrical at the end of a month.
+	if startDay < endDay {
+		return -diff{Start: d.End, End: d.Start}.monthDiff(calendar)
+	}
 
-	var adjust int
-	var anchor2 *Goment
+	wholeMonthDiff := (endYear-startYear)*12 + endMonth - startMonth
+	anchor := addCalendarMonthsPinned(calendar, start, wholeMonthDiff)
 
-	if d.End.ToTime().Sub(anchor.ToTime()) < 0 {
-		anchor2 = d.Start.Clone().Add(wholeMonthDiff-1, "months")
-		adjust = int(d.End.ToTime().Sub(anchor.ToTime()).Seconds() / (anchor.ToTime().Sub(anchor2.ToTime())).Seconds())
+	var adjust float64
+	if end.Before(anchor) {
+		anchor2 := addCalendarMonthsPinned(calendar, start, wholeMonthDiff-1)
+		adjust = float64(end.Sub(anchor)) / float64(anchor.Sub(anchor2))
 	} else {
-		anchor2 = d.Start.Clone().Add(wholeMonthDiff+1, "months")
-		adjust = int(d.End.ToTime().Sub(anchor.ToTime()).Seconds() / (anchor2.ToTime().Sub(anchor.ToTime())).Seconds())
+		anchor2 := addCalendarMonthsPinned(calendar, start, wholeMonthDiff+1)
+		adjust = float64(end.Sub(anchor)) / float64(anchor2.Sub(anchor))
 	}
 
-	if wholeMonthDiff == 0 {
+	months := -(float64(wholeMonthDiff) + adjust)
+	if months == 0 {
+		// Avoid returning negative zero.
 		return 0
 	}
-
-	return -(wholeMonthDiff + adjust)
+	return months
 }
 
 func absFloor(number float64) int {
@@ -1,6 +1,7 @@
 package goment
 
 import (
+	"errors"
 	"time"
 )
 
@@ -8,44 +9,90 @@ import (
 func (g *Goment) Diff(args ...interface{}) int {
 	numArgs := len(args)
 	if numArgs > 0 {
-		units := ""
-
-		input, err := New(args[0])
-		if err != nil {
-			return 0
-		}
+		unit := Second
 
 		if numArgs > 1 {
-			if parsedUnits, ok := args[1].(string); ok {
-				units = parsedUnits
+			if parsedUnit := unitFromArg(args[1]); parsedUnit.IsValid() {
+				unit = parsedUnit
 			}
 		}
 
-		d := diff{
-			Start: g,
-			End:   input,
-		}
+		return g.DiffUnit(args[0], unit)
+	}
+	return 0
+}
+
+// DiffE returns the difference between two Goments as an integer, like Diff. An error is returned if the Goment to
+// compare to can't be created or the units are not supported.
+func (g *Goment) DiffE(args ...interface{}) (int, error) {
+	if len(args) == 0 || len(args) > 2 {
+		return 0, errors.New("Invalid number of arguments")
+	}
+	if err := validateCompareArgs(args, 1); err != nil {
+		return 0, err
+	}
+	return g.Diff(args...), nil
+}
+
+// DiffUnit returns the difference between two Goments as an integer number of the unit.
+func (g *Goment) DiffUnit(input interface{}, unit Unit) int {
+	other, err := New(input)
+	if err != nil {
+		return 0
+	}
+
+	d := newDiff(g, other)
+
+	switch unit {
+	case Millisecond:
+		return int(d.subtract().Milliseconds())
+	case Nanosecond:
+		return int(d.subtract().Nanoseconds())
+	default:
+		return absFloor(d.In(unit))
+	}
+}
+
+// DiffFloat returns the difference between two Goments as a floating point number, like Diff without truncating
+// the result.
+func (g *Goment) DiffFloat(args ...interface{}) float64 {
+	numArgs := len(args)
+	if numArgs > 0 {
+		unit := Second
 
-		switch units {
-		case "y", "year", "years":
-			return d.InYears()
-		case "M", "month", "months":
-			return d.InMonths()
-		case "w", "week", "weeks":
-			return d.InWeeks()
-		case "d", "day", "days":
-			return d.InDays()
-		case "h", "hour", "hours":
-			return d.InHours()
-		case "m", "minute", "minutes":
-			return d.InMinutes()
-		default:
-			return d.InSeconds()
+		if numArgs > 1 {
+			if parsedUnit := unitFromArg(args[1]); parsedUnit.IsValid() {
+				unit = parsedUnit
+			}
 		}
+
+		return g.DiffFloatUnit(args[0], unit)
 	}
 	return 0
 }
 
+// DiffFloatE returns the difference between two Goments as a floating point number, like DiffFloat. An error is
+// returned if the Goment to compare to can't be created or the units are not supported.
+func (g *Goment) DiffFloatE(args ...interface{}) (float64, error) {
+	if len(args) == 0 || len(args) > 2 {
+		return 0, errors.New("Invalid number of arguments")
+	}
+	if err := validateCompareArgs(args, 1); err != nil {
+		return 0, err
+	}
+	return g.DiffFloat(args...), nil
+}
+
+// DiffFloatUnit returns the difference between two Goments as a floating point number of the unit.
+func (g *Goment) DiffFloatUnit(input interface{}, unit Unit) float64 {
+	other, err := New(input)
+	if err != nil {
+		return 0
+	}
+
+	return newDiff(g, other).In(unit)
+}
+
 // DaysInMonth returns the number of days in the set month.
 func (g *Goment) DaysInMonth() int {
 	return daysInMonth(g.Month(), g.Year())
@@ -90,6 +137,16 @@ func (g *Goment) ToISOString() string {
 	return g.ToTime().Format("2006-01-02T15:04:05.999Z07:00")
 }
 
+// ToRFC2822 returns a RFC 2822 standard representation of the Goment time, as used in email headers.
+func (g *Goment) ToRFC2822() string {
+	return g.ToTime().Format(time.RFC1123Z)
+}
+
+// ToRFC1123 returns a RFC 1123 standard representation of the Goment time in GMT, as used in HTTP headers.
+func (g *Goment) ToRFC1123() string {
+	return g.ToTime().UTC().Format("Mon, 02 Jan 2006 15:04:05 GMT")
+}
+
 func daysInMonth(month, year int) int {
 	return time.Date(year, time.Month(month+1), 0, 0, 0, 0, 0, time.UTC).Day()
 }
@@ -99,6 +99,119 @@ func TestDiffFloored(t *testing.T) {
 	assert.Equal(1, simple(DateTime{Year: 2011, Month: 1, Day: 1}).Diff(DateTime{Year: 2010, Month: 1, Day: 1}, "year"), "year rounded down")
 }
 
+func TestDiffMonthPartial(t *testing.T) {
+	assert := assert.New(t)
+
+	// Only whole months are counted, so the 15th of March is 1 month after the 20th of January.
+	assert.Equal(1, simple(DateTime{Year: 2010, Month: 3, Day: 15}).Diff(DateTime{Year: 2010, Month: 1, Day: 20}, "months"), "month diff")
+	assert.Equal(-1, simple(DateTime{Year: 2010, Month: 1, Day: 20}).Diff(DateTime{Year: 2010, Month: 3, Day: 15}, "months"), "month diff")
+}
+
+func TestDiffFloat(t *testing.T) {
+	assert := assert.New(t)
+
+	start := DateTime{Year: 2024, Month: 1, Day: 1}
+
+	assert.InDelta(2+10.0/31, simple(DateTime{Year: 2024, Month: 3, Day: 11}).DiffFloat(start, "months"), 1e-9, "month diff")
+	assert.InDelta(-(2 + 10.0/31), simple(start).DiffFloat(DateTime{Year: 2024, Month: 3, Day: 11}, "months"), 1e-9, "month diff")
+	assert.InDelta((2+10.0/31)/3, simple(DateTime{Year: 2024, Month: 3, Day: 11}).DiffFloat(start, "quarters"), 1e-9, "quarter diff")
+	assert.InDelta(1.5, simple(DateTime{Year: 2025, Month: 7, Day: 1}).DiffFloat(start, "years"), 1e-9, "year diff")
+	assert.InDelta(1.5, simple(DateTime{Year: 2024, Month: 1, Day: 11, Hour: 12}).DiffFloat(start, "weeks"), 1e-9, "week diff")
+	assert.Equal(-0.5, simple(start).DiffFloat(DateTime{Year: 2024, Month: 1, Day: 1, Hour: 12}, "days"), "day diff")
+	assert.Equal(1.5, simple(DateTime{Year: 2024, Month: 1, Day: 1, Minute: 90}).DiffFloat(start, "hours"), "hour diff")
+	assert.Equal(0.5, simple(DateTime{Year: 2024, Month: 1, Day: 1, Second: 30}).DiffFloat(start, "minutes"), "minute diff")
+	assert.Equal(1.25, simple(DateTime{Year: 2024, Month: 1, Day: 1, Nanosecond: 1250000000}).DiffFloat(start), "second diff")
+	assert.Equal(1.5, simple(DateTime{Year: 2024, Month: 1, Day: 1, Nanosecond: 1500000}).DiffFloat(start, "ms"), "millisecond diff")
+	assert.Equal(-1500.0, simple(start).DiffFloatUnit(DateTime{Year: 2024, Month: 1, Day: 1, Nanosecond: 1500}, Nanosecond), "nanosecond diff")
+
+	assert.Equal(0.0, simple(start).DiffFloat(start, "months"))
+	assert.Equal(0.0, simple(start).DiffFloat("not a date", "months"))
+	assert.Equal(0.0, simple(start).DiffFloat())
+}
+
+func TestDiffFloatEndOfMonth(t *testing.T) {
+	assert := assert.New(t)
+
+	// A month from the 31st of January is the end of February.
+	assert.Equal(1.0, simple(DateTime{Year: 2024, Month: 2, Day: 29}).DiffFloat(DateTime{Year: 2024, Month: 1, Day: 31}, "months"))
+	assert.Equal(-1.0, simple(DateTime{Year: 2024, Month: 1, Day: 31}).DiffFloat(DateTime{Year: 2024, Month: 2, Day: 29}, "months"))
+	assert.Equal(2.0, simple(DateTime{Year: 2024, Month: 3, Day: 31}).DiffFloat(DateTime{Year: 2024, Month: 1, Day: 31}, "months"))
+}
+
+func TestDiffFloatAcrossDST(t *testing.T) {
+	assert := assert.New(t)
+
+	chicago := chicagoLocation()
+
+	// The 10th of March 2024 is 23 hours long in Chicago, but is still one day.
+	end := simpleTime(time.Date(2024, 3, 11, 0, 0, 0, 0, chicago))
+	assert.Equal(1.0, end.DiffFloat(time.Date(2024, 3, 10, 0, 0, 0, 0, chicago), "days"))
+	assert.Equal(23.0, end.DiffFloat(time.Date(2024, 3, 10, 0, 0, 0, 0, chicago), "hours"))
+	assert.Equal(1, end.Diff(time.Date(2024, 3, 10, 0, 0, 0, 0, chicago), "days"))
+
+	// Dates in other time zones are compared in the Goment's time zone.
+	assert.Equal(1.0, end.DiffFloat(time.Date(2024, 2, 11, 6, 0, 0, 0, time.UTC), "months"))
+}
+
+func TestDiffDaysAcrossDST(t *testing.T) {
+	assert := assert.New(t)
+
+	chicago := chicagoLocation()
+
+	// Noon on the 9th to noon on the 11th of March 2024 is only 47 hours in Chicago, but is two days.
+	start := time.Date(2024, 3, 9, 12, 0, 0, 0, chicago)
+	end := simpleTime(time.Date(2024, 3, 11, 12, 0, 0, 0, chicago))
+	assert.Equal(47.0, end.DiffFloat(start, "hours"))
+	assert.Equal(2, end.Diff(start, "days"))
+	assert.Equal(-2, simpleTime(start).Diff(end, "days"))
+	assert.Equal(2.0, end.DiffFloat(start, "days"))
+
+	// Noon on the 2nd to noon on the 4th of November 2024 is 49 hours, and is still two days.
+	end = simpleTime(time.Date(2024, 11, 4, 12, 0, 0, 0, chicago))
+	assert.Equal(2, end.Diff(time.Date(2024, 11, 2, 12, 0, 0, 0, chicago), "days"))
+	assert.Equal(1, end.Diff(time.Date(2024, 11, 2, 12, 0, 1, 0, chicago), "days"))
+}
+
+func TestDiffFloatE(t *testing.T) {
+	assert := assert.New(t)
+
+	lib := simpleTime(time.Date(2011, 3, 3, 16, 5, 6, 7, time.UTC))
+	other := simpleTime(time.Date(2011, 3, 4, 4, 5, 6, 7, time.UTC))
+
+	diff, err := lib.DiffFloatE(other, "days")
+	assert.Nil(err)
+	assert.Equal(-0.5, diff)
+
+	_, err = lib.DiffFloatE("not a date")
+	assert.EqualError(err, "Not a matching ISO-8601 date")
+
+	_, err = lib.DiffFloatE(other, "dya")
+	assert.EqualError(err, "Invalid unit dya")
+
+	_, err = lib.DiffFloatE()
+	assert.EqualError(err, "Invalid number of arguments")
+}
+
+func TestDiffE(t *testing.T) {
+	assert := assert.New(t)
+
+	lib := simpleTime(time.Date(2011, 3, 3, 4, 5, 6, 7, time.UTC))
+	other := simpleTime(time.Date(2011, 3, 4, 4, 5, 6, 7, time.UTC))
+
+	diff, err := lib.DiffE(other, "days")
+	assert.Nil(err)
+	assert.Equal(-1, diff)
+
+	_, err = lib.DiffE("not a date")
+	assert.EqualError(err, "Not a matching ISO-8601 date")
+
+	_, err = lib.DiffE(other, "dya")
+	assert.EqualError(err, "Invalid unit dya")
+
+	_, err = lib.DiffE()
+	assert.EqualError(err, "Invalid number of arguments")
+}
+
 func TestDaysInMonth(t *testing.T) {
 	assert := assert.New(t)
 
@@ -157,3 +270,16 @@ func TestToISOString(t *testing.T) {
 	lib := simpleTime(time.Date(2016, 4, 12, 19, 46, 47, 286000000, time.UTC))
 	assert.Equal(t, "2016-04-12T19:46:47.286Z", lib.ToISOString())
 }
+
+func TestToRFC2822(t *testing.T) {
+	lib := simpleTime(time.Date(2016, 11, 1, 1, 23, 45, 0, chicagoLocation()))
+	assert.Equal(t, "Tue, 01 Nov 2016 01:23:45 -0500", lib.ToRFC2822())
+
+	parsed := simpleString(lib.ToRFC2822())
+	assert.True(t, parsed.IsSame(lib))
+}
+
+func TestToRFC1123(t *testing.T) {
+	lib := simpleTime(time.Date(2016, 11, 1, 1, 23, 45, 0, chicagoLocation()))
+	assert.Equal(t, "Tue, 01 Nov 2016 06:23:45 GMT", lib.ToRFC1123())
+}
@@ -0,0 +1,542 @@
+package goment
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nleeper/goment/locales"
+	"github.com/nleeper/goment/regexps"
+)
+
+const (
+	nanosecondsPerSecond = int64(time.Second)
+	nanosecondsPerDay    = int64(24 * time.Hour)
+)
+
+// Duration is a length of time. Months, days & time are kept separately, so a calendar length like "1 month 3 days"
+// is not collapsed into a fixed number of nanoseconds, and is added to a Goment using its calendar.
+type Duration struct {
+	months      int
+	days        int
+	nanoseconds int64
+	locale      locales.LocaleDetails
+}
+
+// DurationParts is a class to define the parts of a Duration.
+type DurationParts struct {
+	Years        int
+	Quarters     int
+	Months       int
+	Weeks        int
+	Days         int
+	Hours        int
+	Minutes      int
+	Seconds      int
+	Milliseconds int
+	Nanoseconds  int
+}
+
+type durationData struct {
+	years       int
+	months      int
+	days        int
+	hours       int
+	minutes     int
+	seconds     int
+	nanoseconds int
+}
+
+// NewDuration creates a Duration. It can be created from a number of nanoseconds, a time.Duration, an ISO 8601
+// duration string, a DurationParts object, another Duration, or an amount and a unit.
+func NewDuration(args ...interface{}) (*Duration, error) {
+	d := &Duration{locale: getGlobalLocaleDetails()}
+
+	switch len(args) {
+	case 0:
+		return d, nil
+	case 1:
+		switch v := args[0].(type) {
+		case int:
+			d.nanoseconds = int64(v)
+		case int64:
+			d.nanoseconds = v
+		case time.Duration:
+			d.nanoseconds = int64(v)
+		case string:
+			return parseISODuration(v)
+		case DurationParts:
+			d.addParts(v)
+		case *Duration:
+			if v == nil {
+				return &Duration{}, errors.New("Invalid argument type")
+			}
+			return v.Clone(), nil
+		case Duration:
+			return v.Clone(), nil
+		default:
+			return &Duration{}, errors.New("Invalid argument type")
+		}
+	case 2:
+		amount, ok := args[0].(int)
+		if !ok {
+			return &Duration{}, errors.New("Invalid argument type")
+		}
+		unit, err := unitFromArgE(args[1])
+		if err != nil {
+			return &Duration{}, err
+		}
+		d.addUnit(amount, unit)
+	default:
+		return &Duration{}, errors.New("Invalid number of arguments")
+	}
+
+	return d, nil
+}
+
+// Clone creates a new copy of the Duration.
+func (d *Duration) Clone() *Duration {
+	copy := *d
+	return &copy
+}
+
+// Add mutates the original Duration by adding another Duration. It accepts the same arguments as NewDuration.
+func (d *Duration) Add(args ...interface{}) *Duration {
+	return d.addDuration(1, args)
+}
+
+// Subtract mutates the original Duration by subtracting another Duration. It accepts the same arguments as NewDuration.
+func (d *Duration) Subtract(args ...interface{}) *Duration {
+	return d.addDuration(-1, args)
+}
+
+// Abs mutates the original Duration by making each of its parts positive.
+func (d *Duration) Abs() *Duration {
+	d.months = abs(d.months)
+	d.days = abs(d.days)
+	if d.nanoseconds < 0 {
+		d.nanoseconds = -d.nanoseconds
+	}
+	return d
+}
+
+// IsZero checks if the Duration has no length.
+func (d *Duration) IsZero() bool {
+	return d.months == 0 && d.days == 0 && d.nanoseconds == 0
+}
+
+// Years gets the number of whole years in the Duration.
+func (d *Duration) Years() int {
+	return d.bubble().years
+}
+
+// Months gets the number of months in the Duration, after whole years are removed (0 to 11).
+func (d *Duration) Months() int {
+	return d.bubble().months
+}
+
+// Weeks gets the number of whole weeks in the days of the Duration.
+func (d *Duration) Weeks() int {
+	return d.Days() / 7
+}
+
+// Days gets the number of days in the Duration, after whole months are removed.
+func (d *Duration) Days() int {
+	return d.bubble().days
+}
+
+// Hours gets the number of hours in the Duration, after whole days are removed (0 to 23).
+func (d *Duration) Hours() int {
+	return d.bubble().hours
+}
+
+// Minutes gets the number of minutes in the Duration, after whole hours are removed (0 to 59).
+func (d *Duration) Minutes() int {
+	return d.bubble().minutes
+}
+
+// Seconds gets the number of seconds in the Duration, after whole minutes are removed (0 to 59).
+func (d *Duration) Seconds() int {
+	return d.bubble().seconds
+}
+
+// Milliseconds gets the number of milliseconds in the Duration, after whole seconds are removed (0 to 999).
+func (d *Duration) Milliseconds() int {
+	return d.bubble().nanoseconds / 1000000
+}
+
+// Nanoseconds gets the number of nanoseconds in the Duration, after whole seconds are removed (0 to 999999999).
+func (d *Duration) Nanoseconds() int {
+	return d.bubble().nanoseconds
+}
+
+// Get is a string getter using the supplied units. Returns 0 if unsupported property.
+func (d *Duration) Get(units string) int {
+	return d.GetUnit(unitFromArg(units))
+}
+
+// GetUnit gets the part of the Duration for the unit. Returns 0 if unsupported unit.
+func (d *Duration) GetUnit(unit Unit) int {
+	switch unit {
+	case Year:
+		return d.Years()
+	case Quarter:
+		return d.Months() / 3
+	case Month:
+		return d.Months()
+	case Week, ISOWeek:
+		return d.Weeks()
+	case Day:
+		return d.Days()
+	case Hour:
+		return d.Hours()
+	case Minute:
+		return d.Minutes()
+	case Second:
+		return d.Seconds()
+	case Millisecond:
+		return d.Milliseconds()
+	case Nanosecond:
+		return d.Nanoseconds()
+	}
+	return 0
+}
+
+// As returns the length of the Duration in the supplied units. Returns 0 if unsupported units.
+func (d *Duration) As(units string) float64 {
+	return d.AsUnit(unitFromArg(units))
+}
+
+// AsUnit returns the length of the Duration in the unit. Months & days are converted using the average length of a
+// month in the Gregorian calendar.
+func (d *Duration) AsUnit(unit Unit) float64 {
+	switch unit {
+	case Year, Quarter, Month:
+		days := float64(d.days) + float64(d.nanoseconds)/float64(nanosecondsPerDay)
+		months := float64(d.months) + daysToMonths(days)
+
+		switch unit {
+		case Year:
+			return months / 12
+		case Quarter:
+			return months / 3
+		default:
+			return months
+		}
+	}
+
+	days := float64(d.days) + math.Round(monthsToDays(float64(d.months)))
+	ns := float64(d.nanoseconds)
+
+	switch unit {
+	case Week, ISOWeek:
+		return days/7 + ns/float64(7*nanosecondsPerDay)
+	case Day:
+		return days + ns/float64(nanosecondsPerDay)
+	case Hour:
+		return days*24 + ns/float64(time.Hour)
+	case Minute:
+		return days*1440 + ns/float64(time.Minute)
+	case Second:
+		return days*86400 + ns/float64(time.Second)
+	case Millisecond:
+		return days*86400000 + ns/float64(time.Millisecond)
+	case Nanosecond:
+		return days*float64(nanosecondsPerDay) + ns
+	}
+	return 0
+}
+
+// Humanize returns the length of the Duration as relative time, like "a month". Pass true to add the future or past
+// suffix, like "in a month". RelativeTimeOptions can be passed to change the thresholds & rounding, but their
+// WithoutSuffix is not used.
+func (d *Duration) Humanize(args ...interface{}) string {
+	withSuffix := false
+
+	for _, arg := range args {
+		if v, ok := arg.(bool); ok {
+			withSuffix = v
+		}
+	}
+
+	r := newRelativeTime(args)
+	format, number := r.format(
+		r.roundAndAbs(d.AsUnit(Second)),
+		r.roundAndAbs(d.AsUnit(Minute)),
+		r.roundAndAbs(d.AsUnit(Hour)),
+		r.roundAndAbs(d.AsUnit(Day)),
+		r.roundAndAbs(d.AsUnit(Week)),
+		r.roundAndAbs(d.AsUnit(Month)),
+		r.roundAndAbs(d.AsUnit(Year)),
+	)
+
+	locale := d.LocaleDetails()
+	return locale.RelativeTime(format, number, !withSuffix, d.AsUnit(Nanosecond) < 0)
+}
+
+// ToISOString returns the ISO 8601 representation of the Duration, like P1Y2M10DT2H30M. Weeks are written as days.
+func (d *Duration) ToISOString() string {
+	if d.IsZero() {
+		return "P0D"
+	}
+
+	negative := d.AsUnit(Nanosecond) < 0
+
+	sign := func(part int64) string {
+		if (part < 0) != negative {
+			return "-"
+		}
+		return ""
+	}
+
+	months := abs(d.months)
+	years := months / 12
+	months %= 12
+
+	days := abs(d.days)
+
+	ns := d.nanoseconds
+	if ns < 0 {
+		ns = -ns
+	}
+	fraction := ns % nanosecondsPerSecond
+	seconds := ns / nanosecondsPerSecond
+	minutes := seconds / 60
+	hours := minutes / 60
+	seconds %= 60
+	minutes %= 60
+
+	var b strings.Builder
+
+	if negative {
+		b.WriteString("-")
+	}
+	b.WriteString("P")
+
+	if years > 0 {
+		b.WriteString(sign(int64(d.months)) + strconv.Itoa(years) + "Y")
+	}
+	if months > 0 {
+		b.WriteString(sign(int64(d.months)) + strconv.Itoa(months) + "M")
+	}
+	if days > 0 {
+		b.WriteString(sign(int64(d.days)) + strconv.Itoa(days) + "D")
+	}
+
+	if ns > 0 {
+		b.WriteString("T")
+	}
+	if hours > 0 {
+		b.WriteString(sign(d.nanoseconds) + strconv.FormatInt(hours, 10) + "H")
+	}
+	if minutes > 0 {
+		b.WriteString(sign(d.nanoseconds) + strconv.FormatInt(minutes, 10) + "M")
+	}
+	if seconds > 0 || fraction > 0 {
+		b.WriteString(sign(d.nanoseconds) + strconv.FormatInt(seconds, 10))
+		if fraction > 0 {
+			b.WriteString("." + strings.TrimRight(zeroFill(int(fraction), 9, false), "0"))
+		}
+		b.WriteString("S")
+	}
+
+	return b.String()
+}
+
+// Locale returns the locale code of the Duration.
+func (d *Duration) Locale() string {
+	return d.LocaleDetails().Code
+}
+
+// LocaleDetails returns the locale details of the Duration.
+func (d *Duration) LocaleDetails() locales.LocaleDetails {
+	if d.locale.Code == "" {
+		return getGlobalLocaleDetails()
+	}
+	return d.locale
+}
+
+// SetLocale sets the locale of the Duration, used by Humanize.
+func (d *Duration) SetLocale(localeCode string) error {
+	locale, err := loadLocale(localeCode)
+	if err != nil {
+		return err
+	}
+
+	d.locale = locale
+
+	return nil
+}
+
+func (d *Duration) addDuration(sign int, args []interface{}) *Duration {
+	other, err := NewDuration(args...)
+	if err == nil {
+		d.months += other.months * sign
+		d.days += other.days * sign
+		d.nanoseconds += other.nanoseconds * int64(sign)
+	}
+	return d
+}
+
+func (d *Duration) addParts(parts DurationParts) {
+	d.addUnit(parts.Years, Year)
+	d.addUnit(parts.Quarters, Quarter)
+	d.addUnit(parts.Months, Month)
+	d.addUnit(parts.Weeks, Week)
+	d.addUnit(parts.Days, Day)
+	d.addUnit(parts.Hours, Hour)
+	d.addUnit(parts.Minutes, Minute)
+	d.addUnit(parts.Seconds, Second)
+	d.addUnit(parts.Milliseconds, Millisecond)
+	d.addUnit(parts.Nanoseconds, Nanosecond)
+}
+
+func (d *Duration) addUnit(amount int, unit Unit) {
+	switch unit {
+	case Year:
+		d.months += amount * 12
+	case Quarter:
+		d.months += amount * 3
+	case Month:
+		d.months += amount
+	case Week, ISOWeek:
+		d.days += amount * 7
+	case Day:
+		d.days += amount
+	case Hour:
+		d.nanoseconds += int64(amount) * int64(time.Hour)
+	case Minute:
+		d.nanoseconds += int64(amount) * int64(time.Minute)
+	case Second:
+		d.nanoseconds += int64(amount) * int64(time.Second)
+	case Millisecond:
+		d.nanoseconds += int64(amount) * int64(time.Millisecond)
+	case Nanosecond:
+		d.nanoseconds += int64(amount)
+	}
+}
+
+// parseISODuration parses an ISO 8601 duration. Fractions of years, months & days are carried into the smaller parts.
+func parseISODuration(duration string) (*Duration, error) {
+	match := regexps.ISODurationRegex.FindStringSubmatch(duration)
+	if match == nil || strings.HasSuffix(duration, "T") || strings.Join(match[2:], "") == "" {
+		return &Duration{}, errors.New("Not a matching ISO-8601 duration")
+	}
+
+	sign := 1.0
+	if match[1] == "-" {
+		sign = -1
+	}
+
+	parts := make([]float64, len(match))
+	for i := 2; i < len(match); i++ {
+		if match[i] != "" {
+			value, err := strconv.ParseFloat(strings.Replace(match[i], ",", ".", 1), 64)
+			if err != nil {
+				return &Duration{}, errors.New("Not a matching ISO-8601 duration")
+			}
+			parts[i] = value * sign
+		}
+	}
+
+	months := parts[2]*12 + parts[3]
+	wholeMonths := math.Trunc(months)
+
+	days := parts[4]*7 + parts[5] + (months-wholeMonths)*monthsToDays(1)
+	wholeDays := math.Trunc(days)
+
+	ns := (days-wholeDays)*float64(nanosecondsPerDay) + parts[6]*float64(time.Hour) + parts[7]*float64(time.Minute)
+
+	d := &Duration{
+		months:      int(wholeMonths),
+		days:        int(wholeDays),
+		nanoseconds: int64(math.Round(ns)),
+		locale:      getGlobalLocaleDetails(),
+	}
+
+	// Seconds are parsed exactly, so that durations with fractional seconds round trip.
+	if match[8] != "" {
+		d.nanoseconds += parseISODurationSeconds(match[8]) * int64(sign)
+	}
+
+	return d, nil
+}
+
+func parseISODurationSeconds(seconds string) int64 {
+	negative := strings.HasPrefix(seconds, "-")
+	seconds = strings.TrimLeft(seconds, "+-")
+
+	parts := strings.SplitN(strings.Replace(seconds, ",", ".", 1), ".", 2)
+
+	whole, _ := strconv.ParseInt(parts[0], 10, 64)
+	ns := whole * nanosecondsPerSecond
+
+	if len(parts) == 2 {
+		fraction := parts[1]
+		if len(fraction) > 9 {
+			fraction = fraction[:9]
+		}
+		fraction += strings.Repeat("0", 9-len(fraction))
+		fractionNs, _ := strconv.ParseInt(fraction, 10, 64)
+		ns += fractionNs
+	}
+
+	if negative {
+		return -ns
+	}
+	return ns
+}
+
+// bubble splits the Duration into its parts, carrying time into days and days into months, like Moment.js does.
+func (d *Duration) bubble() durationData {
+	months, days, ns := d.months, d.days, d.nanoseconds
+
+	// Parts with mixed signs are converted to time, so that each part has the same sign.
+	if !((ns >= 0 && days >= 0 && months >= 0) || (ns <= 0 && days <= 0 && months <= 0)) {
+		ns += int64(absCeil(monthsToDays(float64(months))+float64(days))) * nanosecondsPerDay
+		days, months = 0, 0
+	}
+
+	data := durationData{}
+
+	data.nanoseconds = int(ns % nanosecondsPerSecond)
+
+	seconds := ns / nanosecondsPerSecond
+	data.seconds = int(seconds % 60)
+
+	minutes := seconds / 60
+	data.minutes = int(minutes % 60)
+
+	hours := minutes / 60
+	data.hours = int(hours % 24)
+
+	days += int(hours / 24)
+
+	monthsFromDays := absFloor(daysToMonths(float64(days)))
+	months += monthsFromDays
+	days -= absCeil(monthsToDays(float64(monthsFromDays)))
+
+	data.days = days
+	data.months = months % 12
+	data.years = months / 12
+
+	return data
+}
+
+// daysToMonths converts days to months using the 400 year Gregorian cycle of 146097 days & 4800 months.
+func daysToMonths(days float64) float64 {
+	return days * 4800 / 146097
+}
+
+func monthsToDays(months float64) float64 {
+	return months * 146097 / 4800
+}
+
+func absCeil(number float64) int {
+	if number < 0 {
+		return int(math.Floor(number))
+	}
+	return int(math.Ceil(number))
+}
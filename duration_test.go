@@ -0,0 +1,262 @@
+package goment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func simpleDuration(args ...interface{}) *Duration {
+	d, _ := NewDuration(args...)
+	return d
+}
+
+func TestNewDuration(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(simpleDuration().IsZero())
+	assert.Equal(1500, simpleDuration(1500).Nanoseconds())
+	assert.Equal(2, simpleDuration(2*time.Hour).Hours())
+	assert.Equal(3, simpleDuration(3, "days").Days())
+	assert.Equal(3, simpleDuration(3, Month).Months())
+
+	d := simpleDuration(DurationParts{Years: 1, Months: 1, Days: 3, Hours: 4, Minutes: 5, Seconds: 6, Milliseconds: 7, Nanoseconds: 8})
+	assert.Equal(1, d.Years())
+	assert.Equal(1, d.Months())
+	assert.Equal(3, d.Days())
+	assert.Equal(4, d.Hours())
+	assert.Equal(5, d.Minutes())
+	assert.Equal(6, d.Seconds())
+	assert.Equal(7, d.Milliseconds())
+	assert.Equal(7000008, d.Nanoseconds())
+
+	clone := simpleDuration(d)
+	clone.Add(1, "day")
+	assert.Equal(3, d.Days())
+	assert.Equal(4, clone.Days())
+
+	_, err := NewDuration(1.5)
+	assert.EqualError(err, "Invalid argument type")
+
+	_, err = NewDuration(1, "dya")
+	assert.EqualError(err, "Invalid unit dya")
+
+	_, err = NewDuration(1, "day", 2)
+	assert.EqualError(err, "Invalid number of arguments")
+}
+
+func TestDurationBubblesParts(t *testing.T) {
+	assert := assert.New(t)
+
+	d := simpleDuration(DurationParts{Hours: 49, Minutes: 61, Seconds: 3601})
+	assert.Equal(2, d.Days())
+	assert.Equal(3, d.Hours())
+	assert.Equal(1, d.Minutes())
+	assert.Equal(1, d.Seconds())
+
+	d = simpleDuration(40, "days")
+	assert.Equal(1, d.Months())
+	assert.Equal(9, d.Days())
+
+	d = simpleDuration(15, "months")
+	assert.Equal(1, d.Years())
+	assert.Equal(3, d.Months())
+	assert.Equal(1, d.Get("quarter"))
+
+	d = simpleDuration(-90, "minutes")
+	assert.Equal(-1, d.Hours())
+	assert.Equal(-30, d.Minutes())
+
+	d = simpleDuration(1, "day").Subtract(1, "hour")
+	assert.Equal(0, d.Days())
+	assert.Equal(23, d.Hours())
+
+	assert.Equal(2, simpleDuration(15, "days").Weeks())
+	assert.Equal(2, simpleDuration(15, "days").GetUnit(Week))
+}
+
+func TestDurationArithmetic(t *testing.T) {
+	assert := assert.New(t)
+
+	d := simpleDuration(1, "month")
+	d.Add(simpleDuration(3, "days")).Add(2*time.Hour).Add(30, "minutes")
+
+	assert.Equal(1, d.Months())
+	assert.Equal(3, d.Days())
+	assert.Equal(2, d.Hours())
+	assert.Equal(30, d.Minutes())
+
+	d.Subtract(DurationParts{Months: 1, Days: 3})
+	assert.Equal(0, d.Months())
+	assert.Equal(0, d.Days())
+	assert.Equal(2, d.Hours())
+
+	d.Subtract(5, "hours").Abs()
+	assert.Equal(2, d.Hours())
+	assert.Equal(30, d.Minutes())
+}
+
+func TestDurationAs(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(1.0, simpleDuration(1, "year").As("years"))
+	assert.Equal(12.0, simpleDuration(1, "year").As("months"))
+	assert.Equal(4.0, simpleDuration(1, "year").AsUnit(Quarter))
+	assert.Equal(365.0, simpleDuration(1, "year").As("days"))
+	assert.Equal(2.0, simpleDuration(14, "days").As("weeks"))
+	assert.Equal(1.5, simpleDuration(36, "hours").As("days"))
+	assert.Equal(90.0, simpleDuration(90*time.Minute).As("minutes"))
+	assert.Equal(1500.0, simpleDuration(1500, "ms").As("ms"))
+	assert.Equal(float64(time.Hour), simpleDuration(1, "hour").AsUnit(Nanosecond))
+	assert.Equal(0.0, simpleDuration(1, "hour").As("foo"))
+	assert.InDelta(1.0, simpleDuration(30, "days").As("months"), 0.02)
+}
+
+func TestDurationHumanize(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("a few seconds", simpleDuration(44, "seconds").Humanize())
+	assert.Equal("a minute", simpleDuration(45, "seconds").Humanize())
+	assert.Equal("44 minutes", simpleDuration(44, "minutes").Humanize())
+	assert.Equal("an hour", simpleDuration(45, "minutes").Humanize())
+	assert.Equal("21 hours", simpleDuration(21, "hours").Humanize())
+	assert.Equal("a day", simpleDuration(22, "hours").Humanize())
+	assert.Equal("25 days", simpleDuration(25, "days").Humanize())
+	assert.Equal("a month", simpleDuration(26, "days").Humanize())
+	assert.Equal("a month", simpleDuration(1, "month").Add(3, "days").Humanize())
+	assert.Equal("10 months", simpleDuration(10, "months").Humanize())
+	assert.Equal("a year", simpleDuration(11, "months").Humanize())
+	assert.Equal("2 years", simpleDuration(2, "years").Humanize())
+
+	assert.Equal("in a month", simpleDuration(1, "month").Humanize(true))
+	assert.Equal("a month ago", simpleDuration(-1, "month").Humanize(true))
+}
+
+func TestDurationLocale(t *testing.T) {
+	assert := assert.New(t)
+
+	d := simpleDuration(2, "days")
+	assert.Equal("en", d.Locale())
+
+	assert.Nil(d.SetLocale("es"))
+	assert.Equal("es", d.Locale())
+	assert.Equal("2 días", d.Humanize())
+
+	assert.EqualError(d.SetLocale("xx"), "Locale xx is not supported")
+	assert.Equal("es", d.Locale())
+}
+
+func TestAddGomentDuration(t *testing.T) {
+	assert := assert.New(t)
+
+	lib := simpleTime(time.Date(2017, 1, 1, 15, 0, 0, 0, time.UTC))
+	d := simpleDuration(DurationParts{Months: 1, Days: 3, Hours: 2})
+
+	assert.Equal("2017-02-04T17:00:00+00:00", lib.Clone().Add(d).Format())
+	assert.Equal("2017-02-04T17:00:00+00:00", lib.Clone().Add(*d).Format())
+	assert.Equal("2016-11-28T13:00:00+00:00", lib.Clone().Subtract(d).Format())
+
+	added, err := lib.Clone().AddE(d)
+	assert.Nil(err)
+	assert.Equal("2017-02-04T17:00:00+00:00", added.Format())
+
+	_, err = lib.AddE(d, "days")
+	assert.EqualError(err, "Invalid number of arguments")
+
+	var nilDuration *Duration
+	assert.Equal("2017-01-01T15:00:00+00:00", lib.Clone().Add(nilDuration).Format())
+	assert.Equal("2017-01-01T15:00:00+00:00", lib.Clone().Subtract(nilDuration).Format())
+
+	_, err = lib.AddE(nilDuration)
+	assert.EqualError(err, "Invalid argument type")
+	_, err = lib.SubtractE(nilDuration)
+	assert.EqualError(err, "Invalid argument type")
+	_, err = NewDuration(nilDuration)
+	assert.EqualError(err, "Invalid argument type")
+}
+
+func TestAddGomentDurationAcrossDST(t *testing.T) {
+	lib, _ := NewTz("America/Chicago", "2024-03-09T12:00:00")
+
+	assert.Equal(t, "2024-03-10T12:00:00-05:00", lib.Clone().Add(simpleDuration(1, "day")).Format())
+	assert.Equal(t, "2024-03-10T13:00:00-05:00", lib.Clone().Add(simpleDuration(24, "hours")).Format())
+}
+
+func TestISODurationParsing(t *testing.T) {
+	assert := assert.New(t)
+
+	d := simpleDuration("P1Y2M10DT2H30M")
+	assert.Equal(1, d.Years())
+	assert.Equal(2, d.Months())
+	assert.Equal(10, d.Days())
+	assert.Equal(2, d.Hours())
+	assert.Equal(30, d.Minutes())
+
+	assert.Equal(500000000, simpleDuration("PT0.5S").Nanoseconds())
+	assert.Equal(500000000, simpleDuration("PT0,5S").Nanoseconds())
+	assert.Equal(1, simpleDuration("PT0.000000001S").Nanoseconds())
+	assert.Equal(21, simpleDuration("P3W").Days())
+	assert.Equal(30, simpleDuration("PT0.5H").Minutes())
+	assert.Equal(12, simpleDuration("P0.5D").Hours())
+	assert.Equal(6, simpleDuration("P0.5Y").Months())
+	assert.Equal(15, simpleDuration("P0.5M").Days())
+
+	d = simpleDuration("-P1DT2H")
+	assert.Equal(-1, d.Days())
+	assert.Equal(-2, d.Hours())
+
+	d = simpleDuration("P1DT-2H")
+	assert.Equal(0, d.Days())
+	assert.Equal(22, d.Hours())
+
+	d = simpleDuration("+P1D")
+	assert.Equal(1, d.Days())
+
+	invalid := []string{"", "P", "PT", "P1DT", "1D", "P1H", "PT1D", "P1.D", "PD", "P1Y2Y", "p1d"}
+	for _, duration := range invalid {
+		_, err := NewDuration(duration)
+		assert.EqualError(err, "Not a matching ISO-8601 duration", duration)
+	}
+}
+
+func TestDurationToISOString(t *testing.T) {
+	assert := assert.New(t)
+
+	durations := map[string]string{
+		"P1Y2M10DT2H30M":    "P1Y2M10DT2H30M",
+		"PT0.5S":            "PT0.5S",
+		"PT1.000000001S":    "PT1.000000001S",
+		"P3W":               "P21D",
+		"PT36H":             "PT36H",
+		"-P1Y2M3DT4H5M6.7S": "-P1Y2M3DT4H5M6.7S",
+		"P1DT-2H":           "P1DT-2H",
+		"-P1DT-2H":          "-P1DT-2H",
+		"P0D":               "P0D",
+		"PT0S":              "P0D",
+	}
+
+	for input, output := range durations {
+		assert.Equal(output, simpleDuration(input).ToISOString(), input)
+		assert.Equal(output, simpleDuration(output).ToISOString(), output)
+	}
+
+	assert.Equal("P1M3DT2H", simpleDuration(DurationParts{Months: 1, Days: 3, Hours: 2}).ToISOString())
+	assert.Equal("-PT1.5S", simpleDuration(-1500*time.Millisecond).ToISOString())
+}
+
+func TestAddISODuration(t *testing.T) {
+	assert := assert.New(t)
+
+	lib := simpleTime(time.Date(2017, 1, 1, 15, 0, 0, 0, time.UTC))
+
+	assert.Equal("2018-03-11T17:30:00+00:00", lib.Clone().Add("P1Y2M10DT2H30M").Format())
+	assert.Equal("2016-12-31T15:00:00+00:00", lib.Clone().Subtract("P1D").Format())
+	assert.Equal("2017-01-01T15:00:00+00:00", lib.Clone().Add("P1X").Format())
+
+	_, err := lib.AddE("P1X")
+	assert.EqualError(err, "Not a matching ISO-8601 duration")
+
+	_, err = lib.SubtractE("P1D", "days")
+	assert.EqualError(err, "Invalid number of arguments")
+}
@@ -90,6 +90,64 @@ func loadFormatReplacements() {
 		return g.locale.Weekdays[g.Day()]
 	})
 
+	// Jalali calendar tokens.
+	addFormatReplacement("jM", padding("jMM", 2), "", func(g *Goment) string {
+		return strconv.Itoa(g.JMonth())
+	})
+	addFormatReplacement("jMMM", emptyPadding(), "", func(g *Goment) string {
+		if month := g.JMonth(); month > 0 {
+			return g.locale.JalaliMonthsShort[month-1]
+		}
+		return ""
+	})
+	addFormatReplacement("jMMMM", emptyPadding(), "", func(g *Goment) string {
+		if month := g.JMonth(); month > 0 {
+			return g.locale.JalaliMonths[month-1]
+		}
+		return ""
+	})
+	addFormatReplacement("jD", padding("jDD", 2), "", func(g *Goment) string {
+		return strconv.Itoa(g.JDate())
+	})
+	addFormatReplacement("jDDD", padding("jDDDD", 3), "", func(g *Goment) string {
+		return strconv.Itoa(g.JDayOfYear())
+	})
+	addFormatReplacement("", padding("jYY", 2), "", func(g *Goment) string {
+		return strconv.Itoa(g.JYear() % 100)
+	})
+	addFormatReplacement("", padding("jYYYY", 4), "", func(g *Goment) string {
+		return strconv.Itoa(g.JYear())
+	})
+
+	// Hijri calendar tokens.
+	addFormatReplacement("iM", padding("iMM", 2), "", func(g *Goment) string {
+		return strconv.Itoa(g.IMonth())
+	})
+	addFormatReplacement("iMMM", emptyPadding(), "", func(g *Goment) string {
+		if month := g.IMonth(); month > 0 {
+			return g.locale.HijriMonthsShort[month-1]
+		}
+		return ""
+	})
+	addFormatReplacement("iMMMM", emptyPadding(), "", func(g *Goment) string {
+		if month := g.IMonth(); month > 0 {
+			return g.locale.HijriMonths[month-1]
+		}
+		return ""
+	})
+	addFormatReplacement("iD", padding("iDD", 2), "", func(g *Goment) string {
+		return strconv.Itoa(g.IDate())
+	})
+	addFormatReplacement("iDDD", padding("iDDDD", 3), "", func(g *Goment) string {
+		return strconv.Itoa(g.IDayOfYear())
+	})
+	addFormatReplacement("", padding("iYY", 2), "", func(g *Goment) string {
+		return strconv.Itoa(g.IYear() % 100)
+	})
+	addFormatReplacement("", padding("iYYYY", 4), "", func(g *Goment) string {
+		return strconv.Itoa(g.IYear())
+	})
+
 	addFormatReplacement("e", emptyPadding(), "", func(g *Goment) string {
 		return strconv.Itoa(g.Weekday())
 	})
@@ -161,6 +219,14 @@ func loadFormatReplacements() {
 		return strconv.Itoa(g.Second())
 	})
 
+	// Fractional seconds, S (tenths) to SSSSSSSSS (nanoseconds).
+	for i := 1; i <= 9; i++ {
+		digits := i
+		addFormatReplacement(strings.Repeat("S", digits), emptyPadding(), "", func(g *Goment) string {
+			return zeroFill(g.Nanosecond()/pow10(9-digits), digits, false)
+		})
+	}
+
 	addFormatReplacement("X", emptyPadding(), "", func(g *Goment) string {
 		return fmt.Sprintf("%d", g.ToUnix())
 	})
@@ -239,6 +305,13 @@ func convertFormat(g *Goment, layout string) string {
 	// Replace any Goment locale specific format tokens (LTS, L, LL, etc).
 	layout = expandLocaleFormats(layout, g.locale)
 
+	// Use the month & weekday names the layout calls for, like the genitive months after a day in Russian.
+	if locale, ok := g.locale.ForFormat(layout); ok {
+		formatted := *g
+		formatted.locale = locale
+		g = &formatted
+	}
+
 	// Replace any bracketed text in layout.
 	bracketMatch := regexps.BracketRegex.FindAllString(layout, -1)
 	bracketsFound := len(bracketMatch) > 0
@@ -337,6 +410,14 @@ func zeroFill(val int, length int, forceSign bool) string {
 	return signValue + fmt.Sprintf("%0"+strconv.Itoa(length)+"d", absNumber)
 }
 
+func pow10(exp int) int {
+	result := 1
+	for i := 0; i < exp; i++ {
+		result *= 10
+	}
+	return result
+}
+
 func makeBracketToken(num int) string {
 	return fmt.Sprintf("$%v", num+1)
 }
@@ -56,6 +56,35 @@ func TestFormats(t *testing.T) {
 	}
 }
 
+func TestFractionalSecondFormats(t *testing.T) {
+	assert := assert.New(t)
+
+	formats := map[string]string{
+		"S":         "1",
+		"SS":        "12",
+		"SSS":       "123",
+		"SSSS":      "1234",
+		"SSSSS":     "12345",
+		"SSSSSS":    "123456",
+		"SSSSSSS":   "1234567",
+		"SSSSSSSS":  "12345678",
+		"SSSSSSSSS": "123456789",
+		"ss.SSS":    "50.123",
+	}
+
+	lib := simpleTime(time.Date(2010, 2, 14, 15, 25, 50, 123456789, chicagoLocation()))
+
+	for p, r := range formats {
+		assert.Equal(r, lib.Format(p), p)
+	}
+
+	lib = simpleTime(time.Date(2010, 2, 14, 15, 25, 50, 1000, chicagoLocation()))
+	assert.Equal("0", lib.Format("S"))
+	assert.Equal("000", lib.Format("SSS"))
+	assert.Equal("000001", lib.Format("SSSSSS"))
+	assert.Equal("000001000", lib.Format("SSSSSSSSS"))
+}
+
 func TestDefaultFormat(t *testing.T) {
 	lib := simpleTime(time.Date(2010, 2, 14, 15, 25, 50, 125000000, chicagoLocation()))
 	assert.Equal(t, "2010-02-14T15:25:50-06:00", lib.Format(), "default format")
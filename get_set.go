@@ -2,27 +2,63 @@ package goment
 
 import (
 	"math"
+	"time"
 )
 
 // Get is a string getter using the supplied units. Returns 0 if unsupported property.
+// Like moment, "d", "day" & "days" get the day of the week, and "D", "date" & "dates" get the day of the month.
 func (g *Goment) Get(units string) int {
-	switch units {
-	case "y", "year", "years":
+	if isWeekdayUnits(units) {
+		return g.Day()
+	}
+	return g.GetUnit(unitFromArg(units))
+}
+
+// GetE is a string getter using the supplied units, like Get. An error is returned if the units are not supported.
+func (g *Goment) GetE(units string) (int, error) {
+	if isWeekdayUnits(units) {
+		return g.Day(), nil
+	}
+	unit, err := ParseUnit(units)
+	if err != nil {
+		return 0, err
+	}
+	return g.GetUnit(unit), nil
+}
+
+// GetUnit gets the value of the unit. Returns 0 if unsupported unit.
+func (g *Goment) GetUnit(unit Unit) int {
+	switch unit {
+	case Year:
 		return g.Year()
-	case "M", "month", "months":
+	case Quarter:
+		return g.Quarter()
+	case Month:
 		return g.Month()
-	case "D", "date", "dates":
+	case Week:
+		return g.Week()
+	case ISOWeek:
+		return g.ISOWeek()
+	case Day:
 		return g.Date()
-	case "h", "hour", "hours":
+	case Hour:
 		return g.Hour()
-	case "m", "minute", "minutes":
+	case Minute:
 		return g.Minute()
-	case "s", "second", "seconds":
+	case Second:
 		return g.Second()
-	case "ms", "millisecond", "milliseconds":
+	case Millisecond:
 		return g.Millisecond()
-	case "ns", "nanosecond", "nanoseconds":
+	case Nanosecond:
 		return g.Nanosecond()
+	case JalaliYear:
+		return g.JYear()
+	case JalaliMonth:
+		return g.JMonth()
+	case HijriYear:
+		return g.IYear()
+	case HijriMonth:
+		return g.IMonth()
 	}
 	return 0
 }
@@ -129,24 +165,59 @@ func (g *Goment) ISOWeeksInYear() int {
 }
 
 // Set is a generic setter, accepting units as the first argument, and value as the second.
+// Like moment, "d", "day" & "days" set the day of the week, and "D", "date" & "dates" set the day of the month.
 func (g *Goment) Set(units string, value int) *Goment {
-	switch units {
-	case "y", "year", "years":
+	if isWeekdayUnits(units) {
+		return g.SetDay(value)
+	}
+	return g.SetUnit(unitFromArg(units), value)
+}
+
+// SetE is a generic setter, like Set. An error is returned, and the Goment is unchanged, if the units are not supported.
+func (g *Goment) SetE(units string, value int) (*Goment, error) {
+	if isWeekdayUnits(units) {
+		return g.SetDay(value), nil
+	}
+	unit, err := ParseUnit(units)
+	if err != nil {
+		return g, err
+	}
+	return g.SetUnit(unit, value), nil
+}
+
+// SetUnit sets the unit to the value.
+func (g *Goment) SetUnit(unit Unit, value int) *Goment {
+	switch unit {
+	case Year:
 		return g.SetYear(value)
-	case "M", "month", "months":
+	case Quarter:
+		return g.SetQuarter(value)
+	case Month:
 		return g.SetMonth(value)
-	case "D", "date", "dates":
+	case Week:
+		return g.SetWeek(value)
+	case ISOWeek:
+		return g.SetISOWeek(value)
+	case Day:
 		return g.SetDate(value)
-	case "h", "hour", "hours":
+	case Hour:
 		return g.SetHour(value)
-	case "m", "minute", "minutes":
+	case Minute:
 		return g.SetMinute(value)
-	case "s", "second", "seconds":
+	case Second:
 		return g.SetSecond(value)
-	case "ms", "millisecond", "milliseconds":
+	case Millisecond:
 		return g.SetMillisecond(value)
-	case "ns", "nanosecond", "nanoseconds":
+	case Nanosecond:
 		return g.SetNanosecond(value)
+	case JalaliYear:
+		return g.SetJYear(value)
+	case JalaliMonth:
+		return g.SetJMonth(value)
+	case HijriYear:
+		return g.SetIYear(value)
+	case HijriMonth:
+		return g.SetIMonth(value)
 	}
 	return g
 }
@@ -183,10 +254,11 @@ func (g *Goment) SetMinute(minutes int) *Goment {
 	return g
 }
 
-// SetHour sets the hour.
+// SetHour sets the hour. The hour is set on the wall clock, so the date is kept across daylight saving transitions.
 func (g *Goment) SetHour(hours int) *Goment {
-	if hours >= 0 && hours <= 23 {
-		return g.addHours(hours - g.Hour())
+	if hours >= 0 && hours <= 23 && hours != g.Hour() {
+		t := g.ToTime()
+		g.time = time.Date(t.Year(), t.Month(), t.Day(), hours, t.Minute(), t.Second(), t.Nanosecond(), t.Location())
 	}
 	return g
 }
@@ -21,6 +21,10 @@ func TestGetByUnits(t *testing.T) {
 	assert.Equal(6, lib.Get("D"))
 	assert.Equal(6, lib.Get("date"))
 	assert.Equal(6, lib.Get("dates"))
+	assert.Equal(1, lib.Get("d"))
+	assert.Equal(1, lib.Get("day"))
+	assert.Equal(1, lib.Get("days"))
+	assert.Equal(6, lib.GetUnit(Day))
 	assert.Equal(10, lib.Get("h"))
 	assert.Equal(10, lib.Get("hour"))
 	assert.Equal(10, lib.Get("hours"))
@@ -350,6 +354,18 @@ func TestSetByUnits(t *testing.T) {
 	assert.Equal(100002, lib.Set("nanoseconds", 100002).Nanosecond())
 }
 
+func TestSetDayUnits(t *testing.T) {
+	assert := assert.New(t)
+
+	lib := simpleString("2015-04-06 10:11:12")
+
+	assert.Equal("2015-04-08", lib.Set("d", 3).Format("YYYY-MM-DD"))
+	assert.Equal("2015-04-05", lib.Set("day", 0).Format("YYYY-MM-DD"))
+	assert.Equal("2015-04-11", lib.Set("days", 6).Format("YYYY-MM-DD"))
+	assert.Equal("2015-04-03", lib.Set("D", 3).Format("YYYY-MM-DD"))
+	assert.Equal("2015-04-20", lib.SetUnit(Day, 20).Format("YYYY-MM-DD"))
+}
+
 func TestSetUnknownUnits(t *testing.T) {
 	testTime := time.Date(2011, 10, 11, 15, 20, 1, 10000, chicagoLocation())
 
@@ -483,6 +499,19 @@ func TestSetHour(t *testing.T) {
 	assert.Equal(3, lib.Hour())
 }
 
+func TestSetHourAcrossDST(t *testing.T) {
+	assert := assert.New(t)
+
+	lib := simpleTime(time.Date(2024, 3, 10, 10, 0, 0, 0, chicagoLocation()))
+	assert.Equal("2024-03-10T00:00:00-06:00", lib.SetHour(0).Format())
+
+	lib = simpleTime(time.Date(2024, 11, 3, 12, 0, 0, 0, chicagoLocation()))
+	assert.Equal("2024-11-03T00:00:00-05:00", lib.SetHour(0).Format())
+
+	lib = simpleTime(time.Date(2024, 11, 3, 0, 0, 0, 0, chicagoLocation()))
+	assert.Equal("2024-11-03T12:00:00-06:00", lib.SetHour(12).Format())
+}
+
 func TestSetHourOutOfRange(t *testing.T) {
 	assert := assert.New(t)
 
@@ -801,3 +830,38 @@ func TestSetISOWeekYear(t *testing.T) {
 		assert.Equal(year, simpleString("2012-12-31T00:00:00.000Z").UTC().SetISOWeekYear(year).ISOWeekYear(), fmt.Sprintf("setting iso-week-year to %d", year))
 	}
 }
+
+func TestGetE(t *testing.T) {
+	assert := assert.New(t)
+
+	lib := simpleString("2015-04-06 10:11:12")
+
+	hours, err := lib.GetE("hours")
+	assert.Nil(err)
+	assert.Equal(10, hours)
+
+	day, err := lib.GetE("d")
+	assert.Nil(err)
+	assert.Equal(1, day)
+
+	_, err = lib.GetE("foo")
+	assert.EqualError(err, "Invalid unit foo")
+}
+
+func TestSetE(t *testing.T) {
+	assert := assert.New(t)
+
+	lib := simpleString("2015-04-06 10:11:12")
+
+	_, err := lib.SetE("foo", 3)
+	assert.EqualError(err, "Invalid unit foo")
+	assert.Equal("2015-04-06 10:11:12", lib.Format("YYYY-MM-DD HH:mm:ss"))
+
+	_, err = lib.SetE("month", 3)
+	assert.Nil(err)
+	assert.Equal(3, lib.Month())
+
+	_, err = lib.SetE("d", 3)
+	assert.Nil(err)
+	assert.Equal("2015-03-04", lib.Format("YYYY-MM-DD"))
+}
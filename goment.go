@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/nleeper/goment/locales"
+	"github.com/nleeper/goment/regexps"
 )
 
 var timeNow = time.Now
@@ -14,6 +15,9 @@ var timeNow = time.Now
 type Goment struct {
 	time   time.Time
 	locale locales.LocaleDetails
+	hijri  HijriCalendar
+	valid  bool
+	flags  ParsingFlags
 }
 
 // DateTime is a class to define a date & time.
@@ -30,12 +34,10 @@ type DateTime struct {
 
 var loadReplacementsOnce sync.Once = sync.Once{}
 
-// New creates an instance of the Goment library.
+// New creates an instance of the Goment library. A date string can be parsed with a format string, or with a list
+// of candidate formats in which case the best matching format is used, and optionally a locale code.
 func New(args ...interface{}) (*Goment, error) {
-	loadReplacementsOnce.Do(func() {
-		loadParseReplacements()
-		loadFormatReplacements()
-	})
+	loadReplacements()
 
 	switch len(args) {
 	case 0:
@@ -43,7 +45,7 @@ func New(args ...interface{}) (*Goment, error) {
 	case 1:
 		switch v := args[0].(type) {
 		case string:
-			return fromISOString(v)
+			return fromString(v, time.UTC)
 		case time.Time:
 			return fromExistingTime(v)
 		case int64:
@@ -52,39 +54,72 @@ func New(args ...interface{}) (*Goment, error) {
 			return fromGoment(v)
 		case Goment:
 			return fromGoment(&v)
+		case Immutable:
+			return fromGoment(v.value())
 		case DateTime:
 			return fromDateTime(v)
 		default:
 			return &Goment{}, errors.New("Invalid argument type")
 		}
-	case 2:
-		if date, ok := args[0].(string); ok {
-			if format, ok := args[1].(string); ok {
-				return fromStringWithFormat(date, format, getGlobalLocaleDetails())
-			}
-			return &Goment{}, errors.New("Second argument must be a format string")
-		}
-		return &Goment{}, errors.New("First argument must be a datetime string")
-	case 3:
-		// TODO - cleanup argument parsing.
-		if date, ok := args[0].(string); ok {
-			if format, ok := args[1].(string); ok {
-				if localeCode, ok := args[2].(string); ok {
-					locale, err := loadLocale(localeCode)
-					if err != nil {
-						return &Goment{}, errors.New("Invalid locale code")
-					}
-					return fromStringWithFormat(date, format, locale)
-				}
-			}
-			return &Goment{}, errors.New("Second argument must be a format string")
-		}
-		return &Goment{}, errors.New("First argument must be a datetime string")
+	case 2, 3:
+		return fromFormatArgs(args, false, time.Local)
+	default:
+		return &Goment{}, errors.New("Invalid number of arguments")
+	}
+}
+
+// NewStrict creates an instance of the Goment library by parsing a date string with a format, or a list of candidate
+// formats, and optionally a locale.
+// Unlike New, every token in the format must consume its input exactly, literal and bracketed text must match,
+// no input may be left over and the parsed values must not overflow (e.g. month 13).
+func NewStrict(args ...interface{}) (*Goment, error) {
+	loadReplacements()
+
+	switch len(args) {
+	case 2, 3:
+		return fromFormatArgs(args, true, time.Local)
 	default:
 		return &Goment{}, errors.New("Invalid number of arguments")
 	}
 }
 
+// NewTz creates an instance of the Goment library in the named IANA time zone, like "America/Chicago". It accepts the
+// same arguments as New. Wall-clock input without an offset, such as a DateTime, an ISO 8601 string or a string with a
+// format, is interpreted in the time zone. Input that is already an instant, or that includes an offset, is converted to it.
+func NewTz(zone string, args ...interface{}) (*Goment, error) {
+	loadReplacements()
+
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		return &Goment{}, errors.New("Invalid time zone " + zone)
+	}
+
+	var g *Goment
+
+	switch len(args) {
+	case 1:
+		switch v := args[0].(type) {
+		case string:
+			g, err = fromString(v, loc)
+		case DateTime:
+			v.Location = loc
+			g, err = fromDateTime(v)
+		default:
+			g, err = New(v)
+		}
+	case 2, 3:
+		g, err = fromFormatArgs(args, false, loc)
+	default:
+		g, err = New(args...)
+	}
+
+	if err != nil {
+		return g, err
+	}
+
+	return g.Tz(zone), nil
+}
+
 // Unix creates an instance of the Goment library from the Unix timestamp (seconds since the Unix Epoch).
 func Unix(unixSeconds int64) (*Goment, error) {
 	t := time.Unix(unixSeconds, 0)
@@ -96,6 +131,9 @@ func (g *Goment) Clone() *Goment {
 	copy, _ := New()
 	copy.time = g.ToTime()
 	copy.locale = g.locale
+	copy.hijri = g.hijri
+	copy.valid = g.valid
+	copy.flags = g.flags.clone()
 
 	return copy
 }
@@ -144,22 +182,75 @@ func fromExistingTime(t time.Time) (*Goment, error) {
 	return createGoment(t)
 }
 
-func fromStringWithFormat(date string, format string, locale locales.LocaleDetails) (*Goment, error) {
-	parsed, err := parseFromFormat(date, format, locale)
-	if err != nil {
-		return &Goment{}, err
+func loadReplacements() {
+	loadReplacementsOnce.Do(func() {
+		loadParseReplacements()
+		loadFormatReplacements()
+	})
+}
+
+func fromFormatArgs(args []interface{}, strict bool, loc *time.Location) (*Goment, error) {
+	date, ok := args[0].(string)
+	if !ok {
+		return &Goment{}, errors.New("First argument must be a datetime string")
+	}
+
+	var formats []string
+	switch v := args[1].(type) {
+	case string:
+		formats = []string{v}
+	case []string:
+		formats = v
+	default:
+		return &Goment{}, errors.New("Second argument must be a format string")
+	}
+
+	locale := getGlobalLocaleDetails()
+	if len(args) == 3 {
+		localeCode, ok := args[2].(string)
+		if !ok {
+			return &Goment{}, errors.New("Third argument must be a locale code")
+		}
+
+		var err error
+		if locale, err = loadLocale(localeCode); err != nil {
+			return &Goment{}, errors.New("Invalid locale code")
+		}
 	}
 
-	return createGomentWithLocale(parsed, locale)
+	return fromStringWithFormats(date, formats, locale, strict, loc)
 }
 
-func fromISOString(date string) (*Goment, error) {
-	parsed, err := parseISOString(date)
+func fromStringWithFormats(date string, formats []string, locale locales.LocaleDetails, strict bool, loc *time.Location) (*Goment, error) {
+	parsed, err := parseToGomentFromFormats(date, formats, locale, strict, loc)
 	if err != nil {
+		if parsed != nil {
+			return parsed, err
+		}
 		return &Goment{}, err
 	}
 
-	return createGoment(parsed)
+	g, err := createGomentWithLocale(parsed.ToTime(), locale)
+	g.hijri = parsed.hijri
+	g.flags = parsed.flags
+
+	return g, err
+}
+
+// fromString parses the date as ISO 8601, falling back to RFC 2822. ISO 8601 dates without an offset use the location.
+func fromString(date string, loc *time.Location) (*Goment, error) {
+	parsed, err := parseISOString(date, loc)
+	if err == nil {
+		return createGoment(parsed)
+	}
+
+	if parsedRFC, errRFC := parseRFC2822String(date); errRFC == nil {
+		return createGoment(parsedRFC)
+	} else if regexps.RFC2822Regex.MatchString(date) {
+		return &Goment{}, errRFC
+	}
+
+	return &Goment{}, err
 }
 
 func createGoment(t time.Time) (*Goment, error) {
@@ -167,5 +258,5 @@ func createGoment(t time.Time) (*Goment, error) {
 }
 
 func createGomentWithLocale(t time.Time, ld locales.LocaleDetails) (*Goment, error) {
-	return &Goment{t, ld}, nil
+	return &Goment{time: t, locale: ld, hijri: getGlobalHijriCalendar(), valid: true}, nil
 }
@@ -195,6 +195,48 @@ func TestNewFromGomentReturnsCloneWithSetLocale(t *testing.T) {
 	assert.Equal(lib.Locale(), clone.Locale())
 }
 
+func TestNewTzFromISOString(t *testing.T) {
+	assert := assert.New(t)
+
+	lib, err := NewTz("America/Chicago", "2024-03-10T01:30:00")
+	assert.Nil(err)
+	assert.Equal("2024-03-10T01:30:00-06:00", lib.Format())
+	assert.Equal("America/Chicago", lib.TimeZoneName())
+
+	lib, err = NewTz("America/Chicago", "2024-03-10T12:00:00Z")
+	assert.Nil(err)
+	assert.Equal("2024-03-10T07:00:00-05:00", lib.Format())
+}
+
+func TestNewTzFromFormat(t *testing.T) {
+	assert := assert.New(t)
+
+	lib, err := NewTz("Asia/Tokyo", "05-13-2011 14:00", "MM-DD-YYYY HH:mm")
+	assert.Nil(err)
+	assert.Equal("2011-05-13T14:00:00+09:00", lib.Format())
+
+	lib, err = NewTz("Asia/Tokyo", "05-13-2011 14:00 +00:00", "MM-DD-YYYY HH:mm Z")
+	assert.Nil(err)
+	assert.Equal("2011-05-13T23:00:00+09:00", lib.Format())
+}
+
+func TestNewTzFromDateTime(t *testing.T) {
+	lib, err := NewTz("Europe/London", DateTime{Year: 2015, Month: 7, Day: 25, Hour: 10, Minute: 30})
+	assert.Nil(t, err)
+	assert.Equal(t, "2015-07-25T10:30:00+01:00", lib.Format())
+}
+
+func TestNewTzFromTime(t *testing.T) {
+	lib, err := NewTz("America/Chicago", time.Date(2015, 1, 25, 10, 30, 0, 0, time.UTC))
+	assert.Nil(t, err)
+	assert.Equal(t, "2015-01-25T04:30:00-06:00", lib.Format())
+}
+
+func TestNewTzThrowErrorFromInvalidZone(t *testing.T) {
+	_, err := NewTz("Mars/Olympus_Mons", "2015-01-25")
+	assert.EqualError(t, err, "Invalid time zone Mars/Olympus_Mons")
+}
+
 func TestNewThrowErrorFromInvalidArgumentType(t *testing.T) {
 	_, err := New(1)
 	assert.EqualError(t, err, "Invalid argument type")
@@ -0,0 +1,223 @@
+package goment
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// HijriCalendar is an algorithm for the Hijri (Islamic) calendar.
+type HijriCalendar int
+
+// The Hijri calendars supported by Goment.
+const (
+	// HijriTabular is the tabular Islamic calendar, which has leap years in a 30 year cycle & starts on Friday the 16th of
+	// July 622 in the Julian calendar.
+	HijriTabular HijriCalendar = iota
+	// HijriUmmAlQura is the Umm al-Qura calendar of Saudi Arabia for the years 1300 to 1600, which is the tabular calendar
+	// for other years.
+	HijriUmmAlQura
+)
+
+var (
+	globalHijriCalendar      = HijriTabular
+	globalHijriCalendarMutex sync.RWMutex
+)
+
+// hijriEpoch is the day of 1 Muharram 1 AH, counted from the Unix epoch.
+const hijriEpoch = -492148
+
+// ummAlQuraFirstYear is the first year of the Umm al-Qura table.
+const ummAlQuraFirstYear = 1300
+
+// ummAlQuraMonths has a bit set for each 30 day month of the Umm al-Qura years 1300 to 1600, with Muharram in the lowest
+// bit. The months are from the ICU project's Umm al-Qura calendar.
+var ummAlQuraMonths = []int{
+	0x555, 0x2ab, 0x937, 0x2b6, 0x576, 0x36c, 0xb55, 0xaaa, 0x956, 0x49e, 0x95d, 0x2ba,
+	0x5b5, 0x3aa, 0xb4b, 0xa96, 0x52e, 0x2ad, 0x56d, 0xb5a, 0x752, 0xf25, 0xe8a, 0xd16,
+	0xa56, 0xab5, 0x6b4, 0xda9, 0xb92, 0xb25, 0x64b, 0xa9b, 0x35a, 0x6d9, 0x5d4, 0xda5,
+	0xd4a, 0xa95, 0x536, 0x975, 0x2f4, 0x6e9, 0x6d4, 0x6a9, 0x535, 0x25d, 0x4bd, 0x9ba,
+	0x3b4, 0xb69, 0xb2a, 0xa55, 0x4ad, 0xa5d, 0x2da, 0x6d9, 0xeaa, 0xe94, 0xd2a, 0xc56,
+	0x4ae, 0xa6d, 0x56a, 0xd55, 0xd4a, 0xa93, 0x52b, 0xa5b, 0x53a, 0x6b5, 0xea9, 0xd52,
+	0xd29, 0xa55, 0x4ad, 0x56d, 0xaea, 0x6e4, 0xed1, 0xda2, 0xaaa, 0x95a, 0x2da, 0x5b9,
+	0xbb2, 0x764, 0x6c9, 0x555, 0x2ab, 0x4db, 0xaba, 0x5b4, 0xda9, 0xd52, 0xaa5, 0x92d,
+	0x26d, 0x8ed, 0x2da, 0xad5, 0xaa5, 0xa4b, 0x497, 0x937, 0x2b6, 0x975, 0xd69, 0xd52,
+	0xc95, 0x92b, 0x25b, 0x4db, 0x9d5, 0x5d2, 0xda5, 0xd4a, 0xa95, 0x54d, 0xaad, 0x3aa,
+	0xbd2, 0xbc4, 0xb89, 0xa95, 0x52d, 0x5ad, 0xb6a, 0x6d4, 0xdc9, 0xd92, 0xaa6, 0x956,
+	0x2ae, 0x56d, 0x36a, 0xb55, 0xaaa, 0x94d, 0x49d, 0x95d, 0x2ba, 0x5b5, 0x5aa, 0xd55,
+	0xa9a, 0x92e, 0x26e, 0x55d, 0xada, 0x6d4, 0x6a5, 0xb27, 0xa4d, 0x4ad, 0x56d, 0xb5a,
+	0x754, 0xf49, 0xe92, 0xd26, 0xa56, 0x356, 0x6b5, 0xbaa, 0xb92, 0xb25, 0x68b, 0xa9b,
+	0x55a, 0xada, 0x5b4, 0xda9, 0xb52, 0xa9a, 0x536, 0x276, 0x575, 0xaf2, 0x6d4, 0x6a9,
+	0x555, 0x2ad, 0x4bd, 0x9ba, 0x574, 0xb69, 0xb52, 0xa95, 0x52d, 0xa5d, 0x4da, 0xad9,
+	0x6b2, 0xe95, 0xe2a, 0xc96, 0x92e, 0xaad, 0x56a, 0xd65, 0xd4a, 0xd15, 0x62b, 0xc5b,
+	0x53a, 0x6b5, 0xdb2, 0xd64, 0xd29, 0xa55, 0x4ad, 0x96d, 0xaea, 0x6e8, 0xed1, 0xda4,
+	0xd4a, 0xa6a, 0x2da, 0x5b9, 0xb72, 0xb68, 0x6d1, 0x655, 0x4ab, 0x95b, 0x2ba, 0x5b5,
+	0xda9, 0xd52, 0xca6, 0x94e, 0x46e, 0x95d, 0x4da, 0xad5, 0xaaa, 0xa4d, 0x49b, 0x937,
+	0x4b6, 0x975, 0xd6a, 0xd52, 0xaa5, 0x94b, 0x2ab, 0x55b, 0xad9, 0x5d2, 0xdc5, 0xd92,
+	0xb25, 0x555, 0xab5, 0x5b4, 0xba9, 0x7a2, 0x745, 0x593, 0xaab, 0x4d6, 0x9d6, 0x5d2,
+	0xba5, 0xb4a, 0xa95, 0x4ad, 0x15d, 0x2dd, 0x9da, 0x5b4, 0x5a9, 0x52d, 0x25b, 0x8b7,
+	0x176, 0x56d, 0xb6a, 0xaca, 0xa96, 0x52b, 0x15b, 0x2bb, 0x5b6, 0xdaa, 0xb94, 0xd46,
+	0xa8d, 0x52d, 0xa9d, 0x55a, 0x755, 0x749, 0xf13, 0xe4a, 0xa96, 0x556, 0x6b5, 0xbaa,
+	0xb94,
+}
+
+// ummAlQuraYears are the first days of the Umm al-Qura years, counted from the Unix epoch, and the day after the table.
+var ummAlQuraYears = ummAlQuraYearStarts(civilDay(time.Date(1882, time.November, 12, 0, 0, 0, 0, time.UTC)))
+
+// SetHijriCalendar sets the global Hijri calendar, which is used by Goments created after it is set. The tabular
+// calendar is used by default.
+func SetHijriCalendar(calendar HijriCalendar) {
+	globalHijriCalendarMutex.Lock()
+	defer globalHijriCalendarMutex.Unlock()
+	globalHijriCalendar = calendar
+}
+
+func getGlobalHijriCalendar() HijriCalendar {
+	globalHijriCalendarMutex.RLock()
+	defer globalHijriCalendarMutex.RUnlock()
+	return globalHijriCalendar
+}
+
+// HijriCalendar gets the Hijri calendar of the Goment.
+func (g *Goment) HijriCalendar() HijriCalendar {
+	return g.hijri
+}
+
+// SetHijriCalendar sets the Hijri calendar for only the current Goment instance.
+func (g *Goment) SetHijriCalendar(calendar HijriCalendar) *Goment {
+	g.hijri = calendar
+	return g
+}
+
+func ummAlQuraYearStarts(first int) []int {
+	starts := []int{first}
+	for _, months := range ummAlQuraMonths {
+		days := 0
+		for month := 1; month <= 12; month++ {
+			days += ummAlQuraDaysInMonth(months, month)
+		}
+		starts = append(starts, starts[len(starts)-1]+days)
+	}
+	return starts
+}
+
+func ummAlQuraDaysInMonth(months, month int) int {
+	return 29 + months>>(month-1)&1
+}
+
+// inUmmAlQura checks if a year is in the Umm al-Qura table, when the Umm al-Qura calendar is used.
+func (c HijriCalendar) inUmmAlQura(year int) bool {
+	return c == HijriUmmAlQura && year >= ummAlQuraFirstYear && year < ummAlQuraFirstYear+len(ummAlQuraMonths)
+}
+
+func (c HijriCalendar) name() string {
+	return "Hijri"
+}
+
+func (c HijriCalendar) date(t time.Time) (int, int, int, bool) {
+	days := civilDay(t)
+
+	last := len(ummAlQuraYears) - 1
+	if c == HijriUmmAlQura && days >= ummAlQuraYears[0] && days < ummAlQuraYears[last] {
+		i := sort.SearchInts(ummAlQuraYears, days+1) - 1
+		days -= ummAlQuraYears[i]
+
+		month := 1
+		for ; days >= ummAlQuraDaysInMonth(ummAlQuraMonths[i], month); month++ {
+			days -= ummAlQuraDaysInMonth(ummAlQuraMonths[i], month)
+		}
+		return ummAlQuraFirstYear + i, month, days + 1, true
+	}
+
+	year := floorDiv(30*(days-hijriEpoch)+10646, 10631)
+	month := 1
+	if sinceFirstMonth := days - 29 - tabularHijriDay(year, 1, 1); sinceFirstMonth > 0 {
+		// The months alternate between 30 & 29 days, so a month is 29.5 days on average.
+		month = -floorDiv(-2*sinceFirstMonth, 59) + 1
+	}
+	if month > 12 {
+		month = 12
+	}
+	return year, month, days - tabularHijriDay(year, month, 1) + 1, true
+}
+
+func (c HijriCalendar) time(year, month, day int) (time.Time, bool) {
+	year, month = normalizeMonth(year, month)
+
+	days := tabularHijriDay(year, month, day)
+	if c.inUmmAlQura(year) {
+		months := ummAlQuraMonths[year-ummAlQuraFirstYear]
+		days = ummAlQuraYears[year-ummAlQuraFirstYear] + day - 1
+		for m := 1; m < month; m++ {
+			days += ummAlQuraDaysInMonth(months, m)
+		}
+	}
+
+	return time.Date(1970, time.January, 1+days, 0, 0, 0, 0, time.UTC), true
+}
+
+func (c HijriCalendar) daysInMonth(year, month int) int {
+	year, month = normalizeMonth(year, month)
+
+	if c.inUmmAlQura(year) {
+		return ummAlQuraDaysInMonth(ummAlQuraMonths[year-ummAlQuraFirstYear], month)
+	}
+
+	nextYear, nextMonth := normalizeMonth(year, month+1)
+	return tabularHijriDay(nextYear, nextMonth, 1) - tabularHijriDay(year, month, 1)
+}
+
+// tabularHijriDay returns the day of a date in the tabular Hijri calendar, counted from the Unix epoch.
+func tabularHijriDay(year, month, day int) int {
+	return hijriEpoch - 1 + day + (59*(month-1)+1)/2 + (year-1)*354 + floorDiv(3+11*year, 30)
+}
+
+// IYear gets the Hijri year.
+func (g *Goment) IYear() int {
+	year, _, _ := g.calendarDate(g.hijri)
+	return year
+}
+
+// IMonth gets the Hijri month (Muharram = 1...).
+func (g *Goment) IMonth() int {
+	_, month, _ := g.calendarDate(g.hijri)
+	return month
+}
+
+// IDate gets the day of the Hijri month.
+func (g *Goment) IDate() int {
+	_, _, day := g.calendarDate(g.hijri)
+	return day
+}
+
+// IDayOfYear gets the day of the Hijri year.
+func (g *Goment) IDayOfYear() int {
+	return calendarDayOfYear(g.hijri, g.ToTime())
+}
+
+// IDaysInMonth returns the number of days in the Hijri month.
+func (g *Goment) IDaysInMonth() int {
+	return g.hijri.daysInMonth(g.IYear(), g.IMonth())
+}
+
+// IsILeapYear returns true if the Hijri year is a leap year, which has 355 days.
+func (g *Goment) IsILeapYear() bool {
+	return calendarDaysInYear(g.hijri, g.IYear()) == 355
+}
+
+// SetIYear sets the Hijri year. If the new month has less days, the date is pinned to the end of the month.
+func (g *Goment) SetIYear(year int) *Goment {
+	return g.addCalendarMonths(g.hijri, (year-g.IYear())*12)
+}
+
+// SetIMonth sets the Hijri month (Muharram = 1...). If the new month has less days than the current month, the date is
+// pinned to the end of the target month.
+func (g *Goment) SetIMonth(month int) *Goment {
+	return g.setCalendarMonth(g.hijri, month)
+}
+
+// SetIDate sets the day of the Hijri month. If the date is greater than the number of days in the month, the date is
+// set to the last day of the month.
+func (g *Goment) SetIDate(date int) *Goment {
+	return g.setCalendarDate(g.hijri, date)
+}
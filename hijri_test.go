@@ -0,0 +1,196 @@
+package goment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHijriGetters(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		date  time.Time
+		year  int
+		month int
+		day   int
+	}{
+		{time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC), 1389, 10, 22},
+		{time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC), 1317, 8, 28},
+		{time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), 1420, 9, 24},
+		{time.Date(2023, 7, 19, 0, 0, 0, 0, time.UTC), 1445, 1, 1},
+		{time.Date(2024, 3, 11, 23, 59, 0, 0, time.UTC), 1445, 9, 1},
+		{time.Date(2025, 6, 26, 0, 0, 0, 0, time.UTC), 1446, 12, 29},
+		{time.Date(622, 7, 19, 0, 0, 0, 0, time.UTC), 1, 1, 1},
+	}
+
+	for _, test := range tests {
+		lib := simpleTime(test.date)
+		assert.Equal(test.year, lib.IYear(), test.date.String())
+		assert.Equal(test.month, lib.IMonth(), test.date.String())
+		assert.Equal(test.day, lib.IDate(), test.date.String())
+	}
+
+	lib := simpleTime(time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC))
+	assert.Equal(237, lib.IDayOfYear())
+	assert.Equal(30, lib.IDaysInMonth())
+	assert.True(lib.IsILeapYear())
+	assert.False(lib.Clone().SetIYear(1446).IsILeapYear())
+}
+
+func TestHijriUmmAlQura(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		date     time.Time
+		expected string
+	}{
+		{time.Date(1882, 11, 12, 0, 0, 0, 0, time.UTC), "1300/01/01"},
+		{time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC), "1317/08/29"},
+		{time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC), "1445/09/01"},
+		{time.Date(2025, 6, 26, 0, 0, 0, 0, time.UTC), "1447/01/01"},
+		{time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC), "1523/10/20"},
+		// Dates outside the table use the tabular calendar.
+		{time.Date(1800, 1, 1, 0, 0, 0, 0, time.UTC), "1214/08/04"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(test.expected, simpleTime(test.date).SetHijriCalendar(HijriUmmAlQura).Format("iYYYY/iMM/iDD"), test.date.String())
+	}
+
+	assert.Equal(30, simpleTime(time.Date(2023, 7, 18, 0, 0, 0, 0, time.UTC)).SetHijriCalendar(HijriUmmAlQura).IDaysInMonth())
+	assert.False(simpleTime(time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC)).SetHijriCalendar(HijriUmmAlQura).IsILeapYear())
+}
+
+func TestHijriCalendarPerGoment(t *testing.T) {
+	assert := assert.New(t)
+
+	lib := simpleTime(time.Date(2023, 7, 18, 0, 0, 0, 0, time.UTC))
+	ummAlQura := lib.Clone().SetHijriCalendar(HijriUmmAlQura)
+
+	assert.Equal(HijriTabular, lib.HijriCalendar())
+	assert.Equal("1444/12/29", lib.Format("iYYYY/iMM/iDD"))
+	assert.Equal("1444/12/30", ummAlQura.Format("iYYYY/iMM/iDD"))
+	assert.Equal(HijriUmmAlQura, ummAlQura.Clone().HijriCalendar())
+	assert.Equal(HijriUmmAlQura, lib.Immutable().SetHijriCalendar(HijriUmmAlQura).HijriCalendar())
+
+	SetHijriCalendar(HijriUmmAlQura)
+	defer SetHijriCalendar(HijriTabular)
+
+	assert.Equal(HijriTabular, lib.HijriCalendar())
+	assert.Equal(HijriUmmAlQura, simpleTime(time.Date(2023, 7, 18, 0, 0, 0, 0, time.UTC)).HijriCalendar())
+
+	parsed, err := New("1445/01/01", "iYYYY/iMM/iDD")
+	assert.Nil(err)
+	assert.Equal(HijriUmmAlQura, parsed.HijriCalendar())
+	assert.Equal("2023-07-19", parsed.Format("YYYY-MM-DD"))
+}
+
+func TestHijriRoundTrip(t *testing.T) {
+	assert := assert.New(t)
+
+	start := time.Date(1800, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, calendar := range []HijriCalendar{HijriTabular, HijriUmmAlQura} {
+		for i := 0; i < 365*400; i++ {
+			date := start.AddDate(0, 0, i)
+			year, month, day, _ := calendar.date(date)
+			back, _ := calendar.time(year, month, day)
+			if !assert.True(back.Equal(date) && day >= 1 && day <= calendar.daysInMonth(year, month), date.String()) {
+				return
+			}
+		}
+	}
+}
+
+func TestHijriSetters(t *testing.T) {
+	assert := assert.New(t)
+
+	lib := simpleTime(time.Date(2024, 3, 11, 10, 30, 0, 0, time.UTC))
+
+	assert.Equal("1445/09/15 10:30", lib.Clone().SetIDate(15).Format("iYYYY/iMM/iDD HH:mm"))
+	assert.Equal("1445/09/30", lib.Clone().SetIDate(31).Format("iYYYY/iMM/iDD"))
+	assert.Equal("1445/12/01", lib.Clone().SetIMonth(12).Format("iYYYY/iMM/iDD"))
+	assert.Equal("1446/09/01", lib.Clone().SetIYear(1446).Format("iYYYY/iMM/iDD"))
+	assert.Equal("1445/10/29", lib.Clone().SetIDate(30).SetIMonth(10).Format("iYYYY/iMM/iDD"))
+	assert.Equal("1445/09/01", lib.Clone().SetIMonth(0).SetIDate(32).Format("iYYYY/iMM/iDD"))
+
+	assert.Equal(1445, lib.Get("iYear"))
+	assert.Equal(9, lib.GetUnit(HijriMonth))
+	assert.Equal("1445/05/01", lib.Clone().Set("iM", 5).Format("iYYYY/iMM/iDD"))
+}
+
+func TestHijriManipulation(t *testing.T) {
+	assert := assert.New(t)
+
+	lib := simpleTime(time.Date(2024, 3, 11, 10, 30, 0, 0, time.UTC)).SetIDate(30)
+
+	assert.Equal("1445/10/29 10:30", lib.Clone().Add(1, "iM").Format("iYYYY/iMM/iDD HH:mm"))
+	assert.Equal("1446/09/30", lib.Clone().AddUnit(1, HijriYear).Format("iYYYY/iMM/iDD"))
+	assert.Equal("1444/12/29", lib.Clone().Subtract(9, "iMonths").Format("iYYYY/iMM/iDD"))
+	assert.Equal("1445/01/01 00:00:00", lib.Clone().StartOf("iYear").Format("iYYYY/iMM/iDD HH:mm:ss"))
+	assert.Equal("1445/09/01 00:00:00", lib.Clone().StartOfUnit(HijriMonth).Format("iYYYY/iMM/iDD HH:mm:ss"))
+	assert.Equal("1445/12/30 23:59:59", lib.Clone().EndOf("iYear").Format("iYYYY/iMM/iDD HH:mm:ss"))
+	assert.Equal("1445/09/30 23:59:59", lib.Clone().EndOfUnit(HijriMonth).Format("iYYYY/iMM/iDD HH:mm:ss"))
+}
+
+func TestHijriDiff(t *testing.T) {
+	assert := assert.New(t)
+
+	start := simpleTime(time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC))
+
+	assert.Equal(0, start.Diff(start.Clone().SetIDate(29), "iMonths"))
+	assert.Equal(-1, start.Diff(start.Clone().Add(1, "iMonth"), "iMonths"))
+	assert.Equal(2, start.Clone().Add(2, "iYears").Diff(start, "iYears"))
+	// The 14 days are counted from the 1st of Shawwal, so they are a fraction of its 29 days.
+	assert.InDelta(1+14.0/29, start.Clone().Add(1, "iMonth").SetIDate(15).DiffFloat(start, "iM"), 1e-9)
+}
+
+func TestHijriFormat(t *testing.T) {
+	assert := assert.New(t)
+
+	lib := simpleTime(time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC))
+
+	assert.Equal("1 Ramadan 1445", lib.Format("iD iMMMM iYYYY"))
+	assert.Equal("01 Ram 45", lib.Format("iDD iMMM iYY"))
+	assert.Equal("9/1/1445 237 237", lib.Format("iM/iD/iYYYY iDDD iDDDD"))
+	assert.Equal("2024-03-11 1445-09-01", lib.Format("YYYY-MM-DD iYYYY-iMM-iDD"))
+	assert.Equal("[iYYYY] 1445", lib.Format("[[iYYYY]] iYYYY"))
+
+	lib.SetLocale("fa")
+	assert.Equal("1 رمضان 1445", lib.Format("iD iMMMM iYYYY"))
+}
+
+func TestHijriParsing(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input    string
+		format   string
+		expected string
+	}{
+		{"1445/09/01", "iYYYY/iMM/iDD", "2024-03-11"},
+		{"1 Ramadan 1445", "iD iMMMM iYYYY", "2024-03-11"},
+		{"29 dhu al-hijjah 1446", "iD iMMMM iYYYY", "2025-06-26"},
+		{"01 Ram 45", "iDD iMMM iYY", "2024-03-11"},
+		{"1445-09", "iYYYY-iMM", "2024-03-11"},
+	}
+
+	for _, test := range tests {
+		lib, err := New(test.input, test.format)
+		if assert.NoError(err, test.input) {
+			assert.Equal(test.expected, lib.Format("YYYY-MM-DD"), test.input)
+		}
+	}
+
+	lib, err := New("1445/09/01 14:30", "iYYYY/iMM/iDD HH:mm")
+	assert.NoError(err)
+	assert.Equal("2024-03-11 14:30", lib.Format("YYYY-MM-DD HH:mm"))
+
+	lib, err = New("1445/13/01", "iYYYY/iMM/iDD")
+	assert.NoError(err)
+	assert.False(lib.IsValid())
+
+	_, err = NewStrict("1445/9/1", "iYYYY/iMM/iDD")
+	assert.Error(err)
+}
@@ -0,0 +1,330 @@
+package goment
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nleeper/goment/regexps"
+)
+
+type holidayKind int
+
+const (
+	fixedHoliday holidayKind = iota
+	weekdayHoliday
+	easterHoliday
+	orthodoxEasterHoliday
+)
+
+// Observance is how a holiday that falls on a weekend is moved to a weekday.
+type Observance int
+
+// The observance rules supported by Holiday.
+const (
+	// ObserveActual keeps the holiday on its date.
+	ObserveActual Observance = iota
+	// ObserveNearestWeekday moves a Saturday holiday to Friday & a Sunday holiday to Monday.
+	ObserveNearestWeekday
+	// ObserveNextMonday moves a Saturday or Sunday holiday to Monday.
+	ObserveNextMonday
+	// ObservePreviousFriday moves a Saturday or Sunday holiday to Friday.
+	ObservePreviousFriday
+	// ObserveSundayToMonday moves a Sunday holiday to Monday.
+	ObserveSundayToMonday
+)
+
+var holidayOrdinals = map[string]int{
+	"1st": 1, "2nd": 2, "3rd": 3, "4th": 4, "5th": 5,
+	"first": 1, "second": 2, "third": 3, "fourth": 4, "fifth": 5,
+	"last": -1,
+}
+
+// Holiday is a rule for the date of a holiday each year.
+type Holiday struct {
+	Name       string
+	kind       holidayKind
+	month      int
+	day        int
+	weekday    time.Weekday
+	n          int
+	offset     int
+	observance Observance
+}
+
+// FixedHoliday creates a Holiday on the same month & day each year, like Christmas on 12-25.
+func FixedHoliday(name string, month, day int) Holiday {
+	return Holiday{Name: name, kind: fixedHoliday, month: month, day: day}
+}
+
+// NthWeekdayHoliday creates a Holiday on the nth weekday of a month, like the 4th Thursday of November. A negative
+// n counts from the end of the month, so -1 is the last weekday of the month.
+func NthWeekdayHoliday(name string, n int, weekday time.Weekday, month int) Holiday {
+	return Holiday{Name: name, kind: weekdayHoliday, month: month, weekday: weekday, n: n}
+}
+
+// LastWeekdayHoliday creates a Holiday on the last weekday of a month, like the last Monday of May.
+func LastWeekdayHoliday(name string, weekday time.Weekday, month int) Holiday {
+	return NthWeekdayHoliday(name, -1, weekday, month)
+}
+
+// EasterHoliday creates a Holiday a number of days from Western Easter Sunday, like -2 for Good Friday.
+func EasterHoliday(name string, offset int) Holiday {
+	return Holiday{Name: name, kind: easterHoliday, offset: offset}
+}
+
+// OrthodoxEasterHoliday creates a Holiday a number of days from Orthodox Easter Sunday.
+func OrthodoxEasterHoliday(name string, offset int) Holiday {
+	return Holiday{Name: name, kind: orthodoxEasterHoliday, offset: offset}
+}
+
+// ParseHoliday creates a Holiday from a rule like "12-25", "4th Thursday of November", "last Monday of May",
+// "Easter+1" or "Orthodox Easter-2".
+func ParseHoliday(name, rule string) (Holiday, error) {
+	rule = strings.TrimSpace(rule)
+
+	if match := regexps.HolidayFixedRegex.FindStringSubmatch(rule); match != nil {
+		month, _ := strconv.Atoi(match[1])
+		day, _ := strconv.Atoi(match[2])
+		// A leap year allows the 29th of February.
+		if month >= 1 && month <= 12 && day >= 1 && day <= daysInMonth(month, 2000) {
+			return FixedHoliday(name, month, day), nil
+		}
+	}
+
+	if match := regexps.HolidayWeekdayRegex.FindStringSubmatch(rule); match != nil {
+		weekday, weekdayOk := parseEnglishWeekday(match[2])
+		month, monthOk := parseEnglishMonth(match[3])
+		if weekdayOk && monthOk {
+			return NthWeekdayHoliday(name, holidayOrdinals[strings.ToLower(match[1])], weekday, month), nil
+		}
+	}
+
+	if match := regexps.HolidayEasterRegex.FindStringSubmatch(rule); match != nil {
+		offset, _ := strconv.Atoi(strings.Join(strings.Fields(match[2]), ""))
+		if match[1] != "" {
+			return OrthodoxEasterHoliday(name, offset), nil
+		}
+		return EasterHoliday(name, offset), nil
+	}
+
+	return Holiday{}, errors.New("Invalid holiday rule " + rule)
+}
+
+// Observed returns a copy of the Holiday that is moved to a weekday by the observance.
+func (h Holiday) Observed(observance Observance) Holiday {
+	h.observance = observance
+	return h
+}
+
+// Date returns the date of the Holiday in a year at midnight UTC, or nil if it does not fall in that year, like the
+// 29th of February in a year that is not a leap year.
+func (h Holiday) Date(year int) *Goment {
+	switch h.kind {
+	case fixedHoliday:
+		if h.month < 1 || h.month > 12 || h.day < 1 || h.day > daysInMonth(h.month, year) {
+			return nil
+		}
+		return holidayDate(year, h.month, h.day)
+	case weekdayHoliday:
+		return nthWeekday(year, h.month, h.weekday, h.n)
+	case easterHoliday:
+		return westernEaster(year).Add(h.offset, "days")
+	case orthodoxEasterHoliday:
+		return orthodoxEaster(year).Add(h.offset, "days")
+	}
+	return nil
+}
+
+// ObservedDate returns the date that the Holiday is observed in a year, after the observance moves it, or nil if it
+// does not fall in that year.
+func (h Holiday) ObservedDate(year int) *Goment {
+	g := h.Date(year)
+	if g == nil {
+		return nil
+	}
+
+	switch weekday := time.Weekday(g.Day()); {
+	case weekday == time.Saturday && (h.observance == ObserveNearestWeekday || h.observance == ObservePreviousFriday):
+		return g.Subtract(1, "days")
+	case weekday == time.Saturday && h.observance == ObserveNextMonday:
+		return g.Add(2, "days")
+	case weekday == time.Sunday && h.observance == ObservePreviousFriday:
+		return g.Subtract(2, "days")
+	case weekday == time.Sunday && h.observance != ObserveActual:
+		return g.Add(1, "days")
+	}
+
+	return g
+}
+
+// HolidayDate is a Holiday in a particular year.
+type HolidayDate struct {
+	Name string
+	// Date is the date of the holiday.
+	Date *Goment
+	// Observed is the date that the holiday is observed, which is the same as the Date unless it was moved.
+	Observed *Goment
+}
+
+// HolidayRules is a HolidayCalendar made from Holiday rules.
+type HolidayRules struct {
+	holidays []Holiday
+	mutex    sync.Mutex
+	years    map[int]map[string]string
+}
+
+// NewHolidayRules creates a HolidayRules from Holiday rules.
+func NewHolidayRules(holidays ...Holiday) *HolidayRules {
+	return &HolidayRules{holidays: holidays, years: map[int]map[string]string{}}
+}
+
+// Holidays returns the holidays in a year, ordered by date.
+func (r *HolidayRules) Holidays(year int) []HolidayDate {
+	dates := []HolidayDate{}
+
+	for _, h := range r.holidays {
+		if date := h.Date(year); date != nil {
+			dates = append(dates, HolidayDate{Name: h.Name, Date: date, Observed: h.ObservedDate(year)})
+		}
+	}
+
+	sort.SliceStable(dates, func(i, j int) bool {
+		return dates[i].Date.ToTime().Before(dates[j].Date.ToTime())
+	})
+
+	return dates
+}
+
+// IsHoliday checks if the Goment is on the date of a holiday, or the date that a holiday is observed.
+func (r *HolidayRules) IsHoliday(g *Goment) bool {
+	return r.HolidayName(g) != ""
+}
+
+// HolidayName returns the name of the holiday that the Goment is on, or an empty string if it is not a holiday.
+func (r *HolidayRules) HolidayName(g *Goment) string {
+	year := g.Year()
+	key := holidayKey(g)
+
+	// Observance can move a holiday into the previous or next year.
+	for _, y := range []int{year, year - 1, year + 1} {
+		if name, ok := r.datesInYear(y)[key]; ok {
+			return name
+		}
+	}
+
+	return ""
+}
+
+// datesInYear returns the names of the holidays in a year by their dates & observed dates. They are cached, as the
+// business day methods check every day.
+func (r *HolidayRules) datesInYear(year int) map[string]string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.years == nil {
+		r.years = map[int]map[string]string{}
+	}
+	if dates, ok := r.years[year]; ok {
+		return dates
+	}
+
+	dates := map[string]string{}
+	for _, h := range r.Holidays(year) {
+		if _, ok := dates[holidayKey(h.Date)]; !ok {
+			dates[holidayKey(h.Date)] = h.Name
+		}
+		if _, ok := dates[holidayKey(h.Observed)]; !ok {
+			dates[holidayKey(h.Observed)] = h.Name
+		}
+	}
+
+	r.years[year] = dates
+	return dates
+}
+
+func holidayDate(year, month, day int) *Goment {
+	g, _ := New(DateTime{Year: year, Month: month, Day: day, Location: time.UTC})
+	return g
+}
+
+// nthWeekday returns the nth weekday of a month, counting from the end of the month if n is negative, or nil if the
+// month does not have that many of the weekday.
+func nthWeekday(year, month int, weekday time.Weekday, n int) *Goment {
+	if month < 1 || month > 12 || n == 0 {
+		return nil
+	}
+
+	var g *Goment
+	if n > 0 {
+		// Setting the weekday stays in the same week, which can start in the previous month.
+		g = holidayDate(year, month, 1).SetDay(int(weekday))
+		if g.Month() != month {
+			g.Add(1, "weeks")
+		}
+		g.Add(n-1, "weeks")
+	} else {
+		g = holidayDate(year, month, daysInMonth(month, year)).SetDay(int(weekday))
+		if g.Month() != month {
+			g.Subtract(1, "weeks")
+		}
+		g.Add(n+1, "weeks")
+	}
+
+	if g.Month() != month {
+		return nil
+	}
+	return g
+}
+
+// westernEaster returns Easter Sunday in the Gregorian calendar, using the anonymous Gregorian computus.
+func westernEaster(year int) *Goment {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+
+	return holidayDate(year, (h+l-7*m+114)/31, (h+l-7*m+114)%31+1)
+}
+
+// orthodoxEaster returns Orthodox Easter Sunday, which is calculated in the Julian calendar using Meeus' computus &
+// then moved to the Gregorian calendar.
+func orthodoxEaster(year int) *Goment {
+	a := year % 4
+	b := year % 7
+	c := year % 19
+	d := (19*c + 15) % 30
+	e := (2*a + 4*b - d + 34) % 7
+
+	julianToGregorian := year/100 - year/400 - 2
+	return holidayDate(year, (d+e+114)/31, (d+e+114)%31+1).Add(julianToGregorian, "days")
+}
+
+func parseEnglishWeekday(name string) (time.Weekday, bool) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(d.String(), name) {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
+func parseEnglishMonth(name string) (int, bool) {
+	for m := time.January; m <= time.December; m++ {
+		if strings.EqualFold(m.String(), name) {
+			return int(m), true
+		}
+	}
+	return 0, false
+}
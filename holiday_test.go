@@ -0,0 +1,158 @@
+package goment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func usHolidays() *HolidayRules {
+	return NewHolidayRules(
+		FixedHoliday("New Year's Day", 1, 1).Observed(ObserveNearestWeekday),
+		NthWeekdayHoliday("Martin Luther King Jr. Day", 3, time.Monday, 1),
+		LastWeekdayHoliday("Memorial Day", time.Monday, 5),
+		FixedHoliday("Independence Day", 7, 4).Observed(ObserveNearestWeekday),
+		NthWeekdayHoliday("Labor Day", 1, time.Monday, 9),
+		NthWeekdayHoliday("Thanksgiving Day", 4, time.Thursday, 11),
+		FixedHoliday("Christmas Day", 12, 25).Observed(ObserveNearestWeekday),
+	)
+}
+
+func formatHolidayDates(dates []HolidayDate) []string {
+	formatted := []string{}
+	for _, d := range dates {
+		formatted = append(formatted, d.Name+" "+d.Date.Format("YYYY-MM-DD")+" "+d.Observed.Format("YYYY-MM-DD"))
+	}
+	return formatted
+}
+
+func TestHolidayRulesHolidays(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal([]string{
+		"New Year's Day 2021-01-01 2021-01-01",
+		"Martin Luther King Jr. Day 2021-01-18 2021-01-18",
+		"Memorial Day 2021-05-31 2021-05-31",
+		"Independence Day 2021-07-04 2021-07-05",
+		"Labor Day 2021-09-06 2021-09-06",
+		"Thanksgiving Day 2021-11-25 2021-11-25",
+		"Christmas Day 2021-12-25 2021-12-24",
+	}, formatHolidayDates(usHolidays().Holidays(2021)))
+}
+
+func TestHolidayRulesIsHoliday(t *testing.T) {
+	assert := assert.New(t)
+
+	rules := usHolidays()
+
+	assert.True(rules.IsHoliday(simpleString("2021-07-04")))
+	assert.True(rules.IsHoliday(simpleString("2021-07-05")))
+	assert.True(rules.IsHoliday(simpleTime(time.Date(2021, 11, 25, 18, 0, 0, 0, chicagoLocation()))))
+	assert.False(rules.IsHoliday(simpleString("2021-07-06")))
+
+	// New Year's Day 2022 is a Saturday, so it is observed in 2021.
+	assert.True(rules.IsHoliday(simpleString("2021-12-31")))
+	assert.Equal("New Year's Day", rules.HolidayName(simpleString("2021-12-31")))
+	assert.Equal("", rules.HolidayName(simpleString("2021-12-30")))
+}
+
+func TestHolidayRulesAsBusinessCalendar(t *testing.T) {
+	assert := assert.New(t)
+
+	calendar := BusinessCalendar{Holidays: usHolidays()}
+
+	assert.Equal("2021-11-26", simpleString("2021-11-24").AddBusinessDays(1, calendar).Format("YYYY-MM-DD"))
+	assert.Equal("2021-12-27", simpleString("2021-12-23").AddBusinessDays(1, calendar).Format("YYYY-MM-DD"))
+	assert.Equal("2022-01-03", simpleString("2021-12-30").AddBusinessDays(1, calendar).Format("YYYY-MM-DD"))
+	assert.Equal(253, simpleString("2021-12-31").BusinessDiff("2020-12-31", calendar))
+}
+
+func TestNthWeekdayHoliday(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("2024-11-28", NthWeekdayHoliday("", 4, time.Thursday, 11).Date(2024).Format("YYYY-MM-DD"))
+	assert.Equal("2024-09-02", NthWeekdayHoliday("", 1, time.Monday, 9).Date(2024).Format("YYYY-MM-DD"))
+	assert.Equal("2024-09-01", NthWeekdayHoliday("", 1, time.Sunday, 9).Date(2024).Format("YYYY-MM-DD"))
+	assert.Equal("2024-05-27", LastWeekdayHoliday("", time.Monday, 5).Date(2024).Format("YYYY-MM-DD"))
+	assert.Equal("2024-05-31", LastWeekdayHoliday("", time.Friday, 5).Date(2024).Format("YYYY-MM-DD"))
+	assert.Equal("2024-05-24", NthWeekdayHoliday("", -2, time.Friday, 5).Date(2024).Format("YYYY-MM-DD"))
+	assert.Equal("2024-02-29", NthWeekdayHoliday("", 5, time.Thursday, 2).Date(2024).Format("YYYY-MM-DD"))
+	assert.Nil(NthWeekdayHoliday("", 5, time.Monday, 2).Date(2024))
+	assert.Nil(FixedHoliday("", 2, 29).Date(2023))
+}
+
+func TestEasterHoliday(t *testing.T) {
+	assert := assert.New(t)
+
+	western := map[int]string{2000: "2000-04-23", 2019: "2019-04-21", 2024: "2024-03-31", 2025: "2025-04-20", 2038: "2038-04-25"}
+	for year, date := range western {
+		assert.Equal(date, EasterHoliday("", 0).Date(year).Format("YYYY-MM-DD"))
+	}
+
+	orthodox := map[int]string{2000: "2000-04-30", 2019: "2019-04-28", 2024: "2024-05-05", 2025: "2025-04-20", 2023: "2023-04-16"}
+	for year, date := range orthodox {
+		assert.Equal(date, OrthodoxEasterHoliday("", 0).Date(year).Format("YYYY-MM-DD"))
+	}
+
+	assert.Equal("2024-03-29", EasterHoliday("Good Friday", -2).Date(2024).Format("YYYY-MM-DD"))
+	assert.Equal("2024-04-01", EasterHoliday("Easter Monday", 1).Date(2024).Format("YYYY-MM-DD"))
+	assert.Equal("2024-05-06", OrthodoxEasterHoliday("Orthodox Easter Monday", 1).Date(2024).Format("YYYY-MM-DD"))
+}
+
+func TestHolidayObservance(t *testing.T) {
+	assert := assert.New(t)
+
+	saturday := FixedHoliday("", 12, 25) // 2021-12-25 is a Saturday.
+	sunday := FixedHoliday("", 12, 26)   // 2021-12-26 is a Sunday.
+
+	tests := []struct {
+		observance Observance
+		saturday   string
+		sunday     string
+	}{
+		{ObserveActual, "2021-12-25", "2021-12-26"},
+		{ObserveNearestWeekday, "2021-12-24", "2021-12-27"},
+		{ObserveNextMonday, "2021-12-27", "2021-12-27"},
+		{ObservePreviousFriday, "2021-12-24", "2021-12-24"},
+		{ObserveSundayToMonday, "2021-12-25", "2021-12-27"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(test.saturday, saturday.Observed(test.observance).ObservedDate(2021).Format("YYYY-MM-DD"))
+		assert.Equal(test.sunday, sunday.Observed(test.observance).ObservedDate(2021).Format("YYYY-MM-DD"))
+	}
+
+	assert.Equal("2021-12-25", saturday.Observed(ObserveNearestWeekday).Date(2021).Format("YYYY-MM-DD"))
+	assert.Nil(FixedHoliday("", 2, 29).Observed(ObserveNearestWeekday).ObservedDate(2023))
+}
+
+func TestParseHoliday(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := map[string]string{
+		"12-25":                       "2024-12-25",
+		"2-29":                        "2024-02-29",
+		"4th Thursday of November":    "2024-11-28",
+		"fourth thursday of november": "2024-11-28",
+		"last Monday of May":          "2024-05-27",
+		"1st Monday of September":     "2024-09-02",
+		"Easter":                      "2024-03-31",
+		"Easter+1":                    "2024-04-01",
+		"easter - 2":                  "2024-03-29",
+		"Orthodox Easter":             "2024-05-05",
+		"Orthodox Easter+50":          "2024-06-24",
+	}
+
+	for rule, date := range tests {
+		h, err := ParseHoliday("Holiday", rule)
+		assert.Nil(err, rule)
+		assert.Equal("Holiday", h.Name)
+		assert.Equal(date, h.Date(2024).Format("YYYY-MM-DD"), rule)
+	}
+
+	for _, rule := range []string{"13-01", "2-30", "0-10", "6th Monday of May", "first Funday of May", "last Monday of Smarch", "Easter+x", "Christmas"} {
+		_, err := ParseHoliday("Holiday", rule)
+		assert.EqualError(err, "Invalid holiday rule "+rule)
+	}
+}
@@ -0,0 +1,745 @@
+package goment
+
+import (
+	"time"
+
+	"github.com/nleeper/goment/locales"
+)
+
+// Immutable is a Goment that is never changed once it is created. Manipulations return a new Immutable and leave the
+// receiver and their arguments untouched, so an Immutable can be safely stored in a struct or shared between goroutines.
+type Immutable struct {
+	goment *Goment
+}
+
+// NewImmutable creates an Immutable Goment. It accepts the same arguments as New.
+func NewImmutable(args ...interface{}) (Immutable, error) {
+	g, err := New(args...)
+	if err != nil {
+		return Immutable{}, err
+	}
+	return g.Immutable(), nil
+}
+
+// Immutable creates an Immutable copy of the Goment. Later changes to the Goment do not affect the copy.
+func (g *Goment) Immutable() Immutable {
+	return Immutable{goment: g.Clone()}
+}
+
+// Goment creates a mutable copy of the Immutable.
+func (i Immutable) Goment() *Goment {
+	return i.value().Clone()
+}
+
+func (i Immutable) value() *Goment {
+	if i.goment == nil {
+		return &Goment{}
+	}
+	return i.goment
+}
+
+func (i Immutable) with(change func(g *Goment)) Immutable {
+	g := i.value().Clone()
+	change(g)
+	return Immutable{goment: g}
+}
+
+func (i Immutable) withE(change func(g *Goment) error) (Immutable, error) {
+	g := i.value().Clone()
+	if err := change(g); err != nil {
+		return i, err
+	}
+	return Immutable{goment: g}, nil
+}
+
+// Add returns a new Immutable with time added.
+func (i Immutable) Add(args ...interface{}) Immutable {
+	return i.with(func(g *Goment) { g.Add(args...) })
+}
+
+// AddE returns a new Immutable with time added, or an error if the arguments are not valid.
+func (i Immutable) AddE(args ...interface{}) (Immutable, error) {
+	return i.withE(func(g *Goment) error {
+		_, err := g.AddE(args...)
+		return err
+	})
+}
+
+// AddUnit returns a new Immutable with an amount of the unit added.
+func (i Immutable) AddUnit(amount int, unit Unit) Immutable {
+	return i.with(func(g *Goment) { g.AddUnit(amount, unit) })
+}
+
+// Subtract returns a new Immutable with time subtracted.
+func (i Immutable) Subtract(args ...interface{}) Immutable {
+	return i.with(func(g *Goment) { g.Subtract(args...) })
+}
+
+// SubtractE returns a new Immutable with time subtracted, or an error if the arguments are not valid.
+func (i Immutable) SubtractE(args ...interface{}) (Immutable, error) {
+	return i.withE(func(g *Goment) error {
+		_, err := g.SubtractE(args...)
+		return err
+	})
+}
+
+// SubtractUnit returns a new Immutable with an amount of the unit subtracted.
+func (i Immutable) SubtractUnit(amount int, unit Unit) Immutable {
+	return i.with(func(g *Goment) { g.SubtractUnit(amount, unit) })
+}
+
+// StartOf returns a new Immutable set to the start of a unit of time.
+func (i Immutable) StartOf(units string) Immutable {
+	return i.with(func(g *Goment) { g.StartOf(units) })
+}
+
+// StartOfE returns a new Immutable set to the start of a unit of time, or an error if the units are not supported.
+func (i Immutable) StartOfE(units string) (Immutable, error) {
+	return i.withE(func(g *Goment) error {
+		_, err := g.StartOfE(units)
+		return err
+	})
+}
+
+// StartOfUnit returns a new Immutable set to the start of the unit.
+func (i Immutable) StartOfUnit(unit Unit) Immutable {
+	return i.with(func(g *Goment) { g.StartOfUnit(unit) })
+}
+
+// EndOf returns a new Immutable set to the end of a unit of time.
+func (i Immutable) EndOf(units string) Immutable {
+	return i.with(func(g *Goment) { g.EndOf(units) })
+}
+
+// EndOfE returns a new Immutable set to the end of a unit of time, or an error if the units are not supported.
+func (i Immutable) EndOfE(units string) (Immutable, error) {
+	return i.withE(func(g *Goment) error {
+		_, err := g.EndOfE(units)
+		return err
+	})
+}
+
+// EndOfUnit returns a new Immutable set to the end of the unit.
+func (i Immutable) EndOfUnit(unit Unit) Immutable {
+	return i.with(func(g *Goment) { g.EndOfUnit(unit) })
+}
+
+// Local returns a new Immutable using local time.
+func (i Immutable) Local() Immutable {
+	return i.with(func(g *Goment) { g.Local() })
+}
+
+// UTC returns a new Immutable using UTC time.
+func (i Immutable) UTC() Immutable {
+	return i.with(func(g *Goment) { g.UTC() })
+}
+
+// Tz returns a new Immutable in the named IANA time zone.
+func (i Immutable) Tz(zone string) Immutable {
+	return i.with(func(g *Goment) { g.Tz(zone) })
+}
+
+// SetUTCOffset returns a new Immutable with the UTC offset set.
+func (i Immutable) SetUTCOffset(offset int) Immutable {
+	return i.with(func(g *Goment) { g.SetUTCOffset(offset) })
+}
+
+// SetLocale returns a new Immutable using the locale.
+func (i Immutable) SetLocale(localeCode string) (Immutable, error) {
+	var err error
+	changed := i.with(func(g *Goment) { err = g.SetLocale(localeCode) })
+	if err != nil {
+		return i, err
+	}
+	return changed, nil
+}
+
+// Set returns a new Immutable with the units set to the value.
+func (i Immutable) Set(units string, value int) Immutable {
+	return i.with(func(g *Goment) { g.Set(units, value) })
+}
+
+// SetE returns a new Immutable with the units set to the value, or an error if the units are not supported.
+func (i Immutable) SetE(units string, value int) (Immutable, error) {
+	return i.withE(func(g *Goment) error {
+		_, err := g.SetE(units, value)
+		return err
+	})
+}
+
+// SetUnit returns a new Immutable with the unit set to the value.
+func (i Immutable) SetUnit(unit Unit, value int) Immutable {
+	return i.with(func(g *Goment) { g.SetUnit(unit, value) })
+}
+
+// SetNanosecond returns a new Immutable with the nanoseconds set.
+func (i Immutable) SetNanosecond(nanoseconds int) Immutable {
+	return i.with(func(g *Goment) { g.SetNanosecond(nanoseconds) })
+}
+
+// SetMillisecond returns a new Immutable with the milliseconds set.
+func (i Immutable) SetMillisecond(milliseconds int) Immutable {
+	return i.with(func(g *Goment) { g.SetMillisecond(milliseconds) })
+}
+
+// SetSecond returns a new Immutable with the seconds set.
+func (i Immutable) SetSecond(seconds int) Immutable {
+	return i.with(func(g *Goment) { g.SetSecond(seconds) })
+}
+
+// SetMinute returns a new Immutable with the minutes set.
+func (i Immutable) SetMinute(minutes int) Immutable {
+	return i.with(func(g *Goment) { g.SetMinute(minutes) })
+}
+
+// SetHour returns a new Immutable with the hour set.
+func (i Immutable) SetHour(hours int) Immutable {
+	return i.with(func(g *Goment) { g.SetHour(hours) })
+}
+
+// SetDate returns a new Immutable with the day of the month set.
+func (i Immutable) SetDate(date int) Immutable {
+	return i.with(func(g *Goment) { g.SetDate(date) })
+}
+
+// SetDay returns a new Immutable with the day of the week set.
+func (i Immutable) SetDay(args ...interface{}) Immutable {
+	return i.with(func(g *Goment) { g.SetDay(args...) })
+}
+
+// SetWeekday returns a new Immutable with the locale aware day of the week set.
+func (i Immutable) SetWeekday(weekday int) Immutable {
+	return i.with(func(g *Goment) { g.SetWeekday(weekday) })
+}
+
+// SetISOWeekday returns a new Immutable with the ISO day of the week set.
+func (i Immutable) SetISOWeekday(weekday int) Immutable {
+	return i.with(func(g *Goment) { g.SetISOWeekday(weekday) })
+}
+
+// SetDayOfYear returns a new Immutable with the day of the year set.
+func (i Immutable) SetDayOfYear(doy int) Immutable {
+	return i.with(func(g *Goment) { g.SetDayOfYear(doy) })
+}
+
+// SetWeek returns a new Immutable with the week of the year set.
+func (i Immutable) SetWeek(week int) Immutable {
+	return i.with(func(g *Goment) { g.SetWeek(week) })
+}
+
+// SetISOWeek returns a new Immutable with the ISO week of the year set.
+func (i Immutable) SetISOWeek(week int) Immutable {
+	return i.with(func(g *Goment) { g.SetISOWeek(week) })
+}
+
+// SetMonth returns a new Immutable with the month set.
+func (i Immutable) SetMonth(month int) Immutable {
+	return i.with(func(g *Goment) { g.SetMonth(month) })
+}
+
+// SetQuarter returns a new Immutable with the quarter set.
+func (i Immutable) SetQuarter(quarter int) Immutable {
+	return i.with(func(g *Goment) { g.SetQuarter(quarter) })
+}
+
+// SetYear returns a new Immutable with the year set.
+func (i Immutable) SetYear(year int) Immutable {
+	return i.with(func(g *Goment) { g.SetYear(year) })
+}
+
+// SetWeekYear returns a new Immutable with the locale aware week-year set.
+func (i Immutable) SetWeekYear(weekYear int) Immutable {
+	return i.with(func(g *Goment) { g.SetWeekYear(weekYear) })
+}
+
+// SetISOWeekYear returns a new Immutable with the ISO week-year set.
+func (i Immutable) SetISOWeekYear(weekYear int) Immutable {
+	return i.with(func(g *Goment) { g.SetISOWeekYear(weekYear) })
+}
+
+// SetJYear returns a new Immutable with the Jalali year set.
+func (i Immutable) SetJYear(year int) Immutable {
+	return i.with(func(g *Goment) { g.SetJYear(year) })
+}
+
+// SetJMonth returns a new Immutable with the Jalali month set (Farvardin = 1...).
+func (i Immutable) SetJMonth(month int) Immutable {
+	return i.with(func(g *Goment) { g.SetJMonth(month) })
+}
+
+// SetJDate returns a new Immutable with the day of the Jalali month set.
+func (i Immutable) SetJDate(date int) Immutable {
+	return i.with(func(g *Goment) { g.SetJDate(date) })
+}
+
+// SetIYear returns a new Immutable with the Hijri year set.
+func (i Immutable) SetIYear(year int) Immutable {
+	return i.with(func(g *Goment) { g.SetIYear(year) })
+}
+
+// SetIMonth returns a new Immutable with the Hijri month set (Muharram = 1...).
+func (i Immutable) SetIMonth(month int) Immutable {
+	return i.with(func(g *Goment) { g.SetIMonth(month) })
+}
+
+// SetIDate returns a new Immutable with the day of the Hijri month set.
+func (i Immutable) SetIDate(date int) Immutable {
+	return i.with(func(g *Goment) { g.SetIDate(date) })
+}
+
+// SetHijriCalendar returns a new Immutable using the Hijri calendar.
+func (i Immutable) SetHijriCalendar(calendar HijriCalendar) Immutable {
+	return i.with(func(g *Goment) { g.SetHijriCalendar(calendar) })
+}
+
+// AddBusinessDays returns a new Immutable with business days added.
+func (i Immutable) AddBusinessDays(days int, calendar ...BusinessCalendar) Immutable {
+	return i.with(func(g *Goment) { g.AddBusinessDays(days, calendar...) })
+}
+
+// SubtractBusinessDays returns a new Immutable with business days subtracted.
+func (i Immutable) SubtractBusinessDays(days int, calendar ...BusinessCalendar) Immutable {
+	return i.with(func(g *Goment) { g.SubtractBusinessDays(days, calendar...) })
+}
+
+// NextBusinessDay returns a new Immutable on the next business day.
+func (i Immutable) NextBusinessDay(calendar ...BusinessCalendar) Immutable {
+	return i.with(func(g *Goment) { g.NextBusinessDay(calendar...) })
+}
+
+// PrevBusinessDay returns a new Immutable on the previous business day.
+func (i Immutable) PrevBusinessDay(calendar ...BusinessCalendar) Immutable {
+	return i.with(func(g *Goment) { g.PrevBusinessDay(calendar...) })
+}
+
+// Get is a string getter using the supplied units. Returns 0 if unsupported property.
+func (i Immutable) Get(units string) int {
+	return i.value().Get(units)
+}
+
+// GetE is a string getter using the supplied units, or an error if the units are not supported.
+func (i Immutable) GetE(units string) (int, error) {
+	return i.value().GetE(units)
+}
+
+// GetUnit gets the value of the unit. Returns 0 if unsupported unit.
+func (i Immutable) GetUnit(unit Unit) int {
+	return i.value().GetUnit(unit)
+}
+
+// Nanosecond gets the nanoseconds.
+func (i Immutable) Nanosecond() int {
+	return i.value().Nanosecond()
+}
+
+// Millisecond gets the milliseconds.
+func (i Immutable) Millisecond() int {
+	return i.value().Millisecond()
+}
+
+// Second gets the seconds.
+func (i Immutable) Second() int {
+	return i.value().Second()
+}
+
+// Minute gets the minutes.
+func (i Immutable) Minute() int {
+	return i.value().Minute()
+}
+
+// Hour gets the hour.
+func (i Immutable) Hour() int {
+	return i.value().Hour()
+}
+
+// Date gets the day of the month.
+func (i Immutable) Date() int {
+	return i.value().Date()
+}
+
+// Day gets the day of the week (Sunday = 0...).
+func (i Immutable) Day() int {
+	return i.value().Day()
+}
+
+// Weekday gets the day of the week according to the locale.
+func (i Immutable) Weekday() int {
+	return i.value().Weekday()
+}
+
+// ISOWeekday gets the ISO day of the week with 1 being Monday and 7 being Sunday.
+func (i Immutable) ISOWeekday() int {
+	return i.value().ISOWeekday()
+}
+
+// DayOfYear gets the day of the year.
+func (i Immutable) DayOfYear() int {
+	return i.value().DayOfYear()
+}
+
+// Week gets the week of the year according to the locale.
+func (i Immutable) Week() int {
+	return i.value().Week()
+}
+
+// ISOWeek gets the ISO week of the year.
+func (i Immutable) ISOWeek() int {
+	return i.value().ISOWeek()
+}
+
+// Month gets the month (January = 1...).
+func (i Immutable) Month() int {
+	return i.value().Month()
+}
+
+// Quarter gets the quarter (1 to 4).
+func (i Immutable) Quarter() int {
+	return i.value().Quarter()
+}
+
+// Year gets the year.
+func (i Immutable) Year() int {
+	return i.value().Year()
+}
+
+// WeekYear gets the week-year according to the locale.
+func (i Immutable) WeekYear() int {
+	return i.value().WeekYear()
+}
+
+// ISOWeekYear gets the ISO week-year.
+func (i Immutable) ISOWeekYear() int {
+	return i.value().ISOWeekYear()
+}
+
+// WeeksInYear gets the number of weeks according to locale in the current Goment's year.
+func (i Immutable) WeeksInYear() int {
+	return i.value().WeeksInYear()
+}
+
+// ISOWeeksInYear gets the number of weeks in the current Goment's year, according to ISO weeks.
+func (i Immutable) ISOWeeksInYear() int {
+	return i.value().ISOWeeksInYear()
+}
+
+// DaysInMonth returns the number of days in the set month.
+func (i Immutable) DaysInMonth() int {
+	return i.value().DaysInMonth()
+}
+
+// JYear gets the Jalali year.
+func (i Immutable) JYear() int {
+	return i.value().JYear()
+}
+
+// JMonth gets the Jalali month (Farvardin = 1...).
+func (i Immutable) JMonth() int {
+	return i.value().JMonth()
+}
+
+// JDate gets the day of the Jalali month.
+func (i Immutable) JDate() int {
+	return i.value().JDate()
+}
+
+// JDayOfYear gets the day of the Jalali year.
+func (i Immutable) JDayOfYear() int {
+	return i.value().JDayOfYear()
+}
+
+// JDaysInMonth returns the number of days in the Jalali month.
+func (i Immutable) JDaysInMonth() int {
+	return i.value().JDaysInMonth()
+}
+
+// IYear gets the Hijri year.
+func (i Immutable) IYear() int {
+	return i.value().IYear()
+}
+
+// IMonth gets the Hijri month (Muharram = 1...).
+func (i Immutable) IMonth() int {
+	return i.value().IMonth()
+}
+
+// IDate gets the day of the Hijri month.
+func (i Immutable) IDate() int {
+	return i.value().IDate()
+}
+
+// IDayOfYear gets the day of the Hijri year.
+func (i Immutable) IDayOfYear() int {
+	return i.value().IDayOfYear()
+}
+
+// IDaysInMonth returns the number of days in the Hijri month.
+func (i Immutable) IDaysInMonth() int {
+	return i.value().IDaysInMonth()
+}
+
+// UTCOffset get the UTC offset in minutes.
+func (i Immutable) UTCOffset() int {
+	return i.value().UTCOffset()
+}
+
+// TimeZoneName gets the IANA name of the time zone.
+func (i Immutable) TimeZoneName() string {
+	return i.value().TimeZoneName()
+}
+
+// Locale returns the locale code.
+func (i Immutable) Locale() string {
+	return i.value().Locale()
+}
+
+// LocaleDetails returns the locale details.
+func (i Immutable) LocaleDetails() locales.LocaleDetails {
+	return i.value().LocaleDetails()
+}
+
+// HijriCalendar returns the Hijri calendar.
+func (i Immutable) HijriCalendar() HijriCalendar {
+	return i.value().HijriCalendar()
+}
+
+// IsValid checks if the Immutable was created from a valid date.
+func (i Immutable) IsValid() bool {
+	return i.value().IsValid()
+}
+
+// InvalidAt returns the unit that overflowed when parsing, or an empty string.
+func (i Immutable) InvalidAt() string {
+	return i.value().InvalidAt()
+}
+
+// ParsingFlags returns the details of how the Immutable was parsed.
+func (i Immutable) ParsingFlags() ParsingFlags {
+	return i.value().ParsingFlags()
+}
+
+// IsBefore will check if the Immutable is before another Goment.
+func (i Immutable) IsBefore(args ...interface{}) bool {
+	return i.value().IsBefore(args...)
+}
+
+// IsBeforeE will check if the Immutable is before another Goment, or return an error if it can't compare.
+func (i Immutable) IsBeforeE(args ...interface{}) (bool, error) {
+	return i.value().IsBeforeE(args...)
+}
+
+// IsBeforeUnit will check if the Immutable is before the start of the unit containing another Goment.
+func (i Immutable) IsBeforeUnit(input interface{}, unit Unit) bool {
+	return i.value().IsBeforeUnit(input, unit)
+}
+
+// IsAfter will check if the Immutable is after another Goment.
+func (i Immutable) IsAfter(args ...interface{}) bool {
+	return i.value().IsAfter(args...)
+}
+
+// IsAfterE will check if the Immutable is after another Goment, or return an error if it can't compare.
+func (i Immutable) IsAfterE(args ...interface{}) (bool, error) {
+	return i.value().IsAfterE(args...)
+}
+
+// IsAfterUnit will check if the Immutable is after the end of the unit containing another Goment.
+func (i Immutable) IsAfterUnit(input interface{}, unit Unit) bool {
+	return i.value().IsAfterUnit(input, unit)
+}
+
+// IsSame will check if the Immutable is the same as another Goment.
+func (i Immutable) IsSame(args ...interface{}) bool {
+	return i.value().IsSame(args...)
+}
+
+// IsSameE will check if the Immutable is the same as another Goment, or return an error if it can't compare.
+func (i Immutable) IsSameE(args ...interface{}) (bool, error) {
+	return i.value().IsSameE(args...)
+}
+
+// IsSameUnit will check if the Immutable is in the same unit as another Goment.
+func (i Immutable) IsSameUnit(input interface{}, unit Unit) bool {
+	return i.value().IsSameUnit(input, unit)
+}
+
+// IsSameOrBefore will check if the Immutable is before or the same as another Goment.
+func (i Immutable) IsSameOrBefore(args ...interface{}) bool {
+	return i.value().IsSameOrBefore(args...)
+}
+
+// IsSameOrBeforeE will check if the Immutable is before or the same as another Goment, or return an error if it can't compare.
+func (i Immutable) IsSameOrBeforeE(args ...interface{}) (bool, error) {
+	return i.value().IsSameOrBeforeE(args...)
+}
+
+// IsSameOrBeforeUnit will check if the Immutable is before or in the same unit as another Goment.
+func (i Immutable) IsSameOrBeforeUnit(input interface{}, unit Unit) bool {
+	return i.value().IsSameOrBeforeUnit(input, unit)
+}
+
+// IsSameOrAfter will check if the Immutable is after or the same as another Goment.
+func (i Immutable) IsSameOrAfter(args ...interface{}) bool {
+	return i.value().IsSameOrAfter(args...)
+}
+
+// IsSameOrAfterE will check if the Immutable is after or the same as another Goment, or return an error if it can't compare.
+func (i Immutable) IsSameOrAfterE(args ...interface{}) (bool, error) {
+	return i.value().IsSameOrAfterE(args...)
+}
+
+// IsSameOrAfterUnit will check if the Immutable is after or in the same unit as another Goment.
+func (i Immutable) IsSameOrAfterUnit(input interface{}, unit Unit) bool {
+	return i.value().IsSameOrAfterUnit(input, unit)
+}
+
+// IsBetween will check if the Immutable is between two other Goments.
+func (i Immutable) IsBetween(args ...interface{}) bool {
+	return i.value().IsBetween(args...)
+}
+
+// IsBetweenE will check if the Immutable is between two other Goments, or return an error if it can't compare.
+func (i Immutable) IsBetweenE(args ...interface{}) (bool, error) {
+	return i.value().IsBetweenE(args...)
+}
+
+// IsBetweenUnit will check if the Immutable is between two other Goments at the unit's granularity.
+func (i Immutable) IsBetweenUnit(from, to interface{}, unit Unit, inclusivity string) bool {
+	return i.value().IsBetweenUnit(from, to, unit, inclusivity)
+}
+
+// IsDST checks if the Immutable is in daylight saving time.
+func (i Immutable) IsDST() bool {
+	return i.value().IsDST()
+}
+
+// IsLeapYear returns true if the Immutable's year is a leap year, and false if it is not.
+func (i Immutable) IsLeapYear() bool {
+	return i.value().IsLeapYear()
+}
+
+// IsJLeapYear returns true if the Immutable's Jalali year is a leap year, and false if it is not.
+func (i Immutable) IsJLeapYear() bool {
+	return i.value().IsJLeapYear()
+}
+
+// IsILeapYear returns true if the Immutable's Hijri year is a leap year, and false if it is not.
+func (i Immutable) IsILeapYear() bool {
+	return i.value().IsILeapYear()
+}
+
+// IsBusinessDay checks if the Immutable is on a business day.
+func (i Immutable) IsBusinessDay(calendar ...BusinessCalendar) bool {
+	return i.value().IsBusinessDay(calendar...)
+}
+
+// Diff returns the difference between the Immutable and another Goment as an integer.
+func (i Immutable) Diff(args ...interface{}) int {
+	return i.value().Diff(args...)
+}
+
+// DiffE returns the difference between the Immutable and another Goment, or an error if it can't compare.
+func (i Immutable) DiffE(args ...interface{}) (int, error) {
+	return i.value().DiffE(args...)
+}
+
+// DiffUnit returns the difference between the Immutable and another Goment as an integer number of the unit.
+func (i Immutable) DiffUnit(input interface{}, unit Unit) int {
+	return i.value().DiffUnit(input, unit)
+}
+
+// DiffFloat returns the difference between the Immutable and another Goment as a floating point number.
+func (i Immutable) DiffFloat(args ...interface{}) float64 {
+	return i.value().DiffFloat(args...)
+}
+
+// DiffFloatE returns the difference between the Immutable and another Goment as a floating point number, or an
+// error if it can't compare.
+func (i Immutable) DiffFloatE(args ...interface{}) (float64, error) {
+	return i.value().DiffFloatE(args...)
+}
+
+// DiffFloatUnit returns the difference between the Immutable and another Goment as a floating point number of the
+// unit.
+func (i Immutable) DiffFloatUnit(input interface{}, unit Unit) float64 {
+	return i.value().DiffFloatUnit(input, unit)
+}
+
+// PreciseDiff returns the calendar difference from a date to the Immutable.
+func (i Immutable) PreciseDiff(date interface{}) *PreciseDifference {
+	return i.value().PreciseDiff(date)
+}
+
+// BusinessDiff returns the number of business days from a date to the Immutable.
+func (i Immutable) BusinessDiff(date interface{}, calendar ...BusinessCalendar) int {
+	return i.value().BusinessDiff(date, calendar...)
+}
+
+// Format takes a string of tokens and replaces them with their corresponding values to display the Immutable.
+func (i Immutable) Format(args ...interface{}) string {
+	return i.value().Format(args...)
+}
+
+// FromNow returns the relative time from now to the Immutable time.
+func (i Immutable) FromNow(args ...interface{}) string {
+	return i.value().FromNow(args...)
+}
+
+// ToNow returns the relative time to now to the Immutable time.
+func (i Immutable) ToNow(args ...interface{}) string {
+	return i.value().ToNow(args...)
+}
+
+// From returns the relative time from the supplied time to the Immutable time.
+func (i Immutable) From(args ...interface{}) string {
+	return i.value().From(args...)
+}
+
+// To returns the relative time from the Immutable time to the supplied time.
+func (i Immutable) To(args ...interface{}) string {
+	return i.value().To(args...)
+}
+
+// Calendar displays time relative to a given referenceTime (defaults to now).
+func (i Immutable) Calendar(args ...interface{}) string {
+	return i.value().Calendar(args...)
+}
+
+// ToTime returns a Go Time struct.
+func (i Immutable) ToTime() time.Time {
+	return i.value().ToTime()
+}
+
+// ToUnix returns the Unix timestamp (the number of seconds since the Unix Epoch).
+func (i Immutable) ToUnix() int64 {
+	return i.value().ToUnix()
+}
+
+// ToArray returns an array that mirrors the parameters from time.Date().
+func (i Immutable) ToArray() []int {
+	return i.value().ToArray()
+}
+
+// ToDateTime returns a Goment DateTime struct.
+func (i Immutable) ToDateTime() DateTime {
+	return i.value().ToDateTime()
+}
+
+// ToString returns an English string representation of the Immutable time.
+func (i Immutable) ToString() string {
+	return i.value().ToString()
+}
+
+// ToISOString returns an ISO8601 standard representation of the Immutable time.
+func (i Immutable) ToISOString() string {
+	return i.value().ToISOString()
+}
+
+// ToRFC2822 returns an RFC 2822 representation of the Immutable time.
+func (i Immutable) ToRFC2822() string {
+	return i.value().ToRFC2822()
+}
+
+// ToRFC1123 returns an RFC 1123 representation of the Immutable time in GMT.
+func (i Immutable) ToRFC1123() string {
+	return i.value().ToRFC1123()
+}
@@ -0,0 +1,103 @@
+package goment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewImmutable(t *testing.T) {
+	assert := assert.New(t)
+
+	lib, err := NewImmutable("2011-05-13T14:25:50+00:00")
+	assert.Nil(err)
+	assert.Equal("2011-05-13T14:25:50+00:00", lib.Format())
+
+	_, err = NewImmutable("2011-05a13")
+	assert.EqualError(err, "Not a matching ISO-8601 date")
+}
+
+func TestImmutableManipulationsReturnNewValues(t *testing.T) {
+	assert := assert.New(t)
+
+	lib, _ := NewImmutable("2011-05-13T14:25:50+00:00")
+
+	assert.Equal("2011-05-14T14:25:50+00:00", lib.Add(1, "day").Format())
+	assert.Equal("2011-05-12T14:25:50+00:00", lib.Subtract(1, "day").Format())
+	assert.Equal("2011-05-01T00:00:00+00:00", lib.StartOf("month").Format())
+	assert.Equal("2011-05-31T23:59:59+00:00", lib.EndOf("month").Format())
+	assert.Equal("2012-05-13T14:25:50+00:00", lib.SetYear(2012).Format())
+	assert.Equal("1390/02/01", lib.SetJDate(1).Format("jYYYY/jMM/jDD"))
+	assert.Equal("1432/06/01", lib.SetIDate(1).Format("iYYYY/iMM/iDD"))
+	assert.Equal("2011-05-13T09:25:50-05:00", lib.Tz("America/Chicago").Format())
+	assert.Equal("2011-05-13T16:25:50+02:00", lib.SetUTCOffset(2).Format())
+	assert.Equal("2011-05-13T14:25:50+00:00", lib.Format())
+}
+
+func TestImmutableCopiesGoment(t *testing.T) {
+	assert := assert.New(t)
+
+	g := simpleTime(time.Date(2011, 5, 13, 14, 25, 50, 0, time.UTC))
+	lib := g.Immutable()
+
+	g.Add(1, "year")
+	assert.Equal(2011, lib.Year())
+
+	mutable := lib.Goment()
+	mutable.Add(1, "year")
+	assert.Equal(2011, lib.Year())
+	assert.Equal(2012, mutable.Year())
+}
+
+func TestImmutableSetLocale(t *testing.T) {
+	assert := assert.New(t)
+
+	lib, _ := NewImmutable("2011-05-13T14:25:50+00:00")
+
+	fr, err := lib.SetLocale("fr")
+	assert.Nil(err)
+	assert.Equal("fr", fr.Locale())
+	assert.Equal("en", lib.Locale())
+
+	same, err := lib.SetLocale("xx")
+	assert.EqualError(err, "Locale xx is not supported")
+	assert.Equal("en", same.Locale())
+}
+
+func TestImmutableQueries(t *testing.T) {
+	assert := assert.New(t)
+
+	lib, _ := NewImmutable("2011-05-13T14:25:50+00:00")
+	other, _ := NewImmutable("2011-05-13T18:00:00+00:00")
+
+	assert.True(lib.IsSame(other, "day"))
+	assert.True(lib.IsBefore(other))
+	assert.True(other.IsAfter(lib))
+	assert.Equal(-3, lib.Diff(other, "hours"))
+	assert.InDelta(-3.5694, lib.DiffFloat(other, "hours"), 1e-4)
+	assert.Equal("in 4 hours", other.From(lib))
+	assert.Equal("2011-05-13T14:25:50+00:00", lib.Format())
+	assert.Equal("2011-05-13T18:00:00+00:00", other.Format())
+}
+
+func TestImmutableE(t *testing.T) {
+	assert := assert.New(t)
+
+	lib, _ := NewImmutable("2011-05-13T14:25:50Z")
+
+	same, err := lib.AddE(5, "yeers")
+	assert.EqualError(err, "Invalid unit yeers")
+	assert.Equal(lib, same)
+
+	added, err := lib.AddE(5, "years")
+	assert.Nil(err)
+	assert.Equal(2016, added.Year())
+	assert.Equal(2011, lib.Year())
+
+	_, err = lib.SetE("foo", 1)
+	assert.EqualError(err, "Invalid unit foo")
+
+	_, err = lib.IsBeforeE("not a date")
+	assert.EqualError(err, "Not a matching ISO-8601 date")
+}
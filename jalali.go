@@ -0,0 +1,169 @@
+package goment
+
+import "time"
+
+// The Jalali (Solar Hijri) calendar is used in Iran & Afghanistan. Its years start at the March equinox, so leap years
+// follow the break years of the astronomical calendar, like jalaali-js. Dates between the Jalali years -61 & 3177 are
+// supported.
+var jalaliBreaks = []int{-61, 9, 38, 199, 426, 686, 756, 818, 1111, 1181, 1210, 1635, 2060, 2097, 2192, 2262, 2324, 2394, 2456, 3178}
+
+type jalaliCalendar struct{}
+
+var jalali calendarSystem = jalaliCalendar{}
+
+// jalaliYear is the start of a Jalali year.
+type jalaliYear struct {
+	// gregorianYear is the Gregorian year that the Jalali year starts in.
+	gregorianYear int
+	// march is the day of March that the Jalali year starts on.
+	march int
+	leap  bool
+}
+
+// newJalaliYear returns the start of a Jalali year, and false if the year is not supported.
+func newJalaliYear(year int) (jalaliYear, bool) {
+	last := len(jalaliBreaks) - 1
+	if year < jalaliBreaks[0] || year >= jalaliBreaks[last] {
+		return jalaliYear{}, false
+	}
+
+	leapJ := -14
+	jp := jalaliBreaks[0]
+	jump := 0
+	for i := 1; i <= last; i++ {
+		jump = jalaliBreaks[i] - jp
+		if year < jalaliBreaks[i] {
+			break
+		}
+		leapJ += jump/33*8 + jump%33/4
+		jp = jalaliBreaks[i]
+	}
+
+	n := year - jp
+	leapJ += n/33*8 + (n%33+3)/4
+	if jump%33 == 4 && jump-n == 4 {
+		leapJ++
+	}
+
+	gregorianYear := year + 621
+	leapG := gregorianYear/4 - (gregorianYear/100+1)*3/4 - 150
+
+	if jump-n < 6 {
+		n = n - jump + (jump+4)/33*33
+	}
+	leap := ((n+1)%33 - 1) % 4
+
+	return jalaliYear{
+		gregorianYear: gregorianYear,
+		march:         20 + leapJ - leapG,
+		leap:          leap == 0,
+	}, true
+}
+
+// start returns the first day of the Jalali year at midnight UTC.
+func (y jalaliYear) start() time.Time {
+	return time.Date(y.gregorianYear, time.March, y.march, 0, 0, 0, 0, time.UTC)
+}
+
+func (jalaliCalendar) name() string {
+	return "Jalali"
+}
+
+func (jalaliCalendar) date(t time.Time) (int, int, int, bool) {
+	year := t.Year() - 621
+	jy, ok := newJalaliYear(year)
+	if !ok {
+		return 0, 0, 0, false
+	}
+
+	days := civilDay(t) - civilDay(jy.start())
+	if days < 0 {
+		year--
+		if jy, ok = newJalaliYear(year); !ok {
+			return 0, 0, 0, false
+		}
+		days = civilDay(t) - civilDay(jy.start())
+	}
+
+	// The first six months have 31 days, the next five have 30, and the last has 29 or 30.
+	if days < 186 {
+		return year, days/31 + 1, days%31 + 1, true
+	}
+	days -= 186
+	return year, days/30 + 7, days%30 + 1, true
+}
+
+func (jalaliCalendar) time(year, month, day int) (time.Time, bool) {
+	year, month = normalizeMonth(year, month)
+
+	jy, ok := newJalaliYear(year)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	dayOfYear := (month-1)*31 - month/7*(month-7) + day
+	return jy.start().AddDate(0, 0, dayOfYear-1), true
+}
+
+func (jalaliCalendar) daysInMonth(year, month int) int {
+	switch {
+	case month <= 6:
+		return 31
+	case month <= 11:
+		return 30
+	}
+	if jy, ok := newJalaliYear(year); ok && jy.leap {
+		return 30
+	}
+	return 29
+}
+
+// JYear gets the Jalali year, or 0 if the date is not supported.
+func (g *Goment) JYear() int {
+	year, _, _ := g.calendarDate(jalali)
+	return year
+}
+
+// JMonth gets the Jalali month (Farvardin = 1...), or 0 if the date is not supported.
+func (g *Goment) JMonth() int {
+	_, month, _ := g.calendarDate(jalali)
+	return month
+}
+
+// JDate gets the day of the Jalali month, or 0 if the date is not supported.
+func (g *Goment) JDate() int {
+	_, _, day := g.calendarDate(jalali)
+	return day
+}
+
+// JDayOfYear gets the day of the Jalali year, or 0 if the date is not supported.
+func (g *Goment) JDayOfYear() int {
+	return calendarDayOfYear(jalali, g.ToTime())
+}
+
+// JDaysInMonth returns the number of days in the Jalali month.
+func (g *Goment) JDaysInMonth() int {
+	return jalali.daysInMonth(g.JYear(), g.JMonth())
+}
+
+// IsJLeapYear returns true if the Jalali year is a leap year, which has 366 days.
+func (g *Goment) IsJLeapYear() bool {
+	return calendarDaysInYear(jalali, g.JYear()) == 366
+}
+
+// SetJYear sets the Jalali year. If the 30th of Esfand is not in the new year, the date is pinned to the 29th.
+func (g *Goment) SetJYear(year int) *Goment {
+	return g.addCalendarMonths(jalali, (year-g.JYear())*12)
+}
+
+// SetJMonth sets the Jalali month (Farvardin = 1...). If the new month has less days than the current month, the date
+// is pinned to the end of the target month.
+func (g *Goment) SetJMonth(month int) *Goment {
+	return g.setCalendarMonth(jalali, month)
+}
+
+// SetJDate sets the day of the Jalali month. If the date is greater than the number of days in the month, the date is
+// set to the last day of the month.
+func (g *Goment) SetJDate(date int) *Goment {
+	return g.setCalendarDate(jalali, date)
+}
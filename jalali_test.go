@@ -0,0 +1,185 @@
+package goment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJalaliGetters(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		date     string
+		expected []int
+	}{
+		{"1979-02-11", []int{1357, 11, 22, 328}},
+		{"2000-01-01", []int{1378, 10, 11, 287}},
+		{"2023-03-20", []int{1401, 12, 29, 365}},
+		{"2023-03-21", []int{1402, 1, 1, 1}},
+		{"2023-09-22", []int{1402, 6, 31, 186}},
+		{"2023-09-23", []int{1402, 7, 1, 187}},
+		{"2025-03-20", []int{1403, 12, 30, 366}},
+		{"2025-03-21", []int{1404, 1, 1, 1}},
+	}
+
+	for _, test := range tests {
+		lib := simpleString(test.date)
+		assert.Equal(test.expected, []int{lib.JYear(), lib.JMonth(), lib.JDate(), lib.JDayOfYear()}, test.date)
+	}
+
+	assert.Equal(0, simpleTime(time.Date(4000, 1, 1, 0, 0, 0, 0, time.UTC)).JYear())
+}
+
+func TestJalaliLeapYears(t *testing.T) {
+	assert := assert.New(t)
+
+	leap := map[int]bool{}
+	for _, year := range []int{1370, 1375, 1379, 1383, 1387, 1391, 1395, 1399, 1403, 1408, 1412} {
+		leap[year] = true
+	}
+
+	for year := 1370; year <= 1412; year++ {
+		lib := simpleString("2000-01-01").SetJYear(year)
+		assert.Equal(leap[year], lib.IsJLeapYear(), year)
+
+		days := 29
+		if leap[year] {
+			days = 30
+		}
+		assert.Equal(days, lib.SetJMonth(12).JDaysInMonth(), year)
+	}
+}
+
+func TestJalaliRoundTrip(t *testing.T) {
+	assert := assert.New(t)
+
+	start := time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 365*200; i++ {
+		date := start.AddDate(0, 0, i)
+		year, month, day, ok := jalali.date(date)
+		back, _ := jalali.time(year, month, day)
+		if !assert.True(ok && back.Equal(date) && day <= jalali.daysInMonth(year, month), date.String()) {
+			return
+		}
+	}
+}
+
+func TestJalaliSetters(t *testing.T) {
+	assert := assert.New(t)
+
+	lib := simpleTime(time.Date(2024, 5, 31, 10, 30, 0, 0, time.UTC))
+
+	assert.Equal("1403/03/11 10:30", lib.Clone().SetJDate(11).Format("jYYYY/jMM/jDD HH:mm"))
+	assert.Equal("1403/03/31 10:30", lib.Clone().SetJDate(31).Format("jYYYY/jMM/jDD HH:mm"))
+	assert.Equal("1403/12/11 10:30", lib.Clone().SetJMonth(12).Format("jYYYY/jMM/jDD HH:mm"))
+	assert.Equal("1400/03/11 10:30", lib.Clone().SetJYear(1400).Format("jYYYY/jMM/jDD HH:mm"))
+	assert.Equal("1402/12/29", lib.Clone().SetJMonth(12).SetJDate(30).SetJYear(1402).Format("jYYYY/jMM/jDD"))
+	assert.Equal("1403/07/30", lib.Clone().SetJDate(31).SetJMonth(7).Format("jYYYY/jMM/jDD"))
+	assert.Equal("1403/03/11", lib.Clone().SetJMonth(13).SetJDate(0).Format("jYYYY/jMM/jDD"))
+
+	assert.Equal(1403, lib.Get("jYear"))
+	assert.Equal(3, lib.GetUnit(JalaliMonth))
+	assert.Equal("1403/05/11", lib.Clone().Set("jM", 5).Format("jYYYY/jMM/jDD"))
+}
+
+func TestJalaliManipulation(t *testing.T) {
+	assert := assert.New(t)
+
+	lib := simpleTime(time.Date(2024, 5, 31, 10, 30, 0, 0, time.UTC))
+
+	assert.Equal("1403/04/11", lib.Clone().Add(1, "jMonth").Format("jYYYY/jMM/jDD"))
+	assert.Equal("1402/03/11", lib.Clone().Subtract(1, "jYear").Format("jYYYY/jMM/jDD"))
+	assert.Equal("1402/12/29", simpleString("2024-03-19").Add(12, "jM").Subtract(12, "jM").Format("jYYYY/jMM/jDD"))
+	assert.Equal("1402/12/29", simpleString("2025-03-20").Subtract(1, "jy").Format("jYYYY/jMM/jDD"))
+	assert.Equal("1403/07/30", simpleString("2024-09-21").Add(1, "jM").Format("jYYYY/jMM/jDD"))
+
+	assert.Equal("2024-05-21T00:00:00+00:00", lib.Clone().StartOf("jMonth").Format())
+	assert.Equal("2024-06-20T23:59:59+00:00", lib.Clone().EndOf("jMonth").Format())
+	assert.Equal("2024-03-20T00:00:00+00:00", lib.Clone().StartOf("jYear").Format())
+	assert.Equal("2025-03-20T23:59:59+00:00", lib.Clone().EndOf("jYear").Format())
+
+	assert.True(lib.IsSame(simpleString("2024-06-20"), "jMonth"))
+	assert.False(lib.IsSame(simpleString("2024-06-21"), "jMonth"))
+	assert.True(lib.IsBefore(simpleString("2025-03-21"), "jYear"))
+}
+
+func TestJalaliDiff(t *testing.T) {
+	assert := assert.New(t)
+
+	lib := simpleString("2024-05-31")
+
+	assert.Equal(12, lib.Diff(simpleString("2023-05-31"), "jM"))
+	assert.Equal(1, lib.Diff(simpleString("2023-05-31"), "jYear"))
+	assert.Equal(0, lib.DiffUnit(simpleString("2024-06-30"), JalaliMonth))
+	assert.Equal(-1, lib.DiffUnit(simpleString("2024-07-01"), JalaliMonth))
+	assert.Equal(1.0, simpleString("2024-04-20").DiffFloat(simpleString("2024-03-20"), "jM"))
+	// The 15 days are counted back from the 16th of Farvardin, so they are a fraction of the 29 days from the 16th of Esfand.
+	assert.InDelta(15.0/29, simpleString("2024-04-04").DiffFloat(simpleString("2024-03-20"), "jM"), 1e-9)
+}
+
+func TestJalaliFormat(t *testing.T) {
+	assert := assert.New(t)
+
+	lib := simpleTime(time.Date(2023, 10, 7, 15, 25, 50, 0, time.UTC))
+
+	formats := map[string]string{
+		"jYYYY/jMM/jDD":    "1402/07/15",
+		"jYY jM jD":        "02 7 15",
+		"jMMMM jMMM":       "Mehr Meh",
+		"jDDD jDDDD":       "201 201",
+		"jD jMMMM jYYYY":   "15 Mehr 1402",
+		"[jYYYY] jYYYY":    "jYYYY 1402",
+		"YYYY-MM-DD jYYYY": "2023-10-07 1402",
+	}
+
+	for format, expected := range formats {
+		assert.Equal(expected, lib.Format(format), format)
+	}
+
+	lib.SetLocale("fa")
+	assert.Equal("15 مهر 1402", lib.Format("jD jMMMM jYYYY"))
+}
+
+func TestJalaliParsing(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		date     string
+		format   string
+		expected string
+	}{
+		{"1402/07/15", "jYYYY/jMM/jDD", "2023-10-07"},
+		{"1402-7-15 15:25", "jYYYY-jM-jD HH:mm", "2023-10-07T15:25"},
+		{"15 Mehr 1402", "jD jMMMM jYYYY", "2023-10-07"},
+		{"15 meh 02", "jD jMMM jYY", "2023-10-07"},
+		{"1357/11/22", "jYYYY/jMM/jDD", "1979-02-11"},
+		{"1403", "jYYYY", "2024-03-20"},
+		{"1403/12/30", "jYYYY/jMM/jDD", "2025-03-20"},
+	}
+
+	for _, test := range tests {
+		lib, err := New(test.date, test.format)
+		assert.Nil(err, test.date)
+		if len(test.expected) > 10 {
+			assert.Equal(test.expected, lib.Format("YYYY-MM-DDTHH:mm"), test.date)
+		} else {
+			assert.Equal(test.expected, lib.Format("YYYY-MM-DD"), test.date)
+		}
+	}
+
+	lib, err := New("15 مهر 1402", "jD jMMMM jYYYY", "fa")
+	assert.Nil(err)
+	assert.Equal("2023-10-07", lib.Format("YYYY-MM-DD"))
+
+	lib, _ = New("1402/12/30", "jYYYY/jMM/jDD")
+	assert.Equal("date", lib.ParsingFlags().Overflow)
+	assert.False(lib.IsValid())
+
+	_, err = NewStrict("1402/12/30", "jYYYY/jMM/jDD")
+	assert.EqualError(err, "Parsed date has values out of range")
+
+	_, err = New("5000/01/01", "jYYYY/jMM/jDD")
+	assert.EqualError(err, "Jalali year 5000 is not supported")
+}
@@ -18,6 +18,7 @@ var supportedLocales = map[string]locales.LocaleDetails{
 	"pt-br": locales.PtBRLocale,
 	"id":    locales.IdLocale,
 	"ru":    locales.RuLocale,
+	"ar":    locales.ArLocale,
 }
 
 var globalLocale = loadKnownLocale(DefaultLocaleCode)
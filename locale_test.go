@@ -1843,3 +1843,239 @@ func TestRuWeekdaysWithLocaleDow(t *testing.T) {
 
 	assert.Equal(t, longDays, lib.Weekdays(true))
 }
+
+func TestRuFormat(t *testing.T) {
+	assert := assert.New(t)
+
+	formats := map[string]string{
+		"MMMM":             "Февраль",
+		"MMMM YYYY":        "Февраль 2010",
+		"D MMMM":           "14 февраля",
+		"Do MMMM":          "14-го февраля",
+		"DD [числа] MMMM":  "14 числа февраля",
+		"MMM":              "Фев",
+		"D MMM":            "14 февр.",
+		"dddd":             "Воскресенье",
+		"[В прошлое] dddd": "В прошлое воскресенье",
+		"LL":               "14 февраля 2010 г.",
+		"LLL":              "14 февраля 2010 г., 15:25",
+		"LLLL":             "Воскресенье, 14 февраля 2010 г., 15:25",
+		"ll":               "14 февр. 2010 г.",
+		"[today is] LL":    "today is 14 февраля 2010 г.",
+		"YYYY-MM-DD, MMMM": "2010-02-14, Февраль",
+		"dddd [в] LT":      "Воскресенье в 15:25",
+	}
+
+	lib := simpleTime(time.Date(2010, 2, 14, 15, 25, 50, 125000000, time.UTC))
+	lib.SetLocale("ru")
+
+	for p, r := range formats {
+		assert.Equal(r, lib.Format(p), p)
+	}
+}
+
+func TestRuFormatParsing(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		date     string
+		format   string
+		expected string
+	}{
+		{"5 января 2024", "D MMMM YYYY", "2024-01-05"},
+		{"5 Январь 2024", "D MMMM YYYY", "2024-01-05"},
+		{"Март 2024", "MMMM YYYY", "2024-03-01"},
+		{"1 марта 2024", "D MMMM YYYY", "2024-03-01"},
+		{"31 мая 2024", "D MMMM YYYY", "2024-05-31"},
+		{"1 мар. 2024", "D MMM YYYY", "2024-03-01"},
+		{"1 Мар 2024", "D MMM YYYY", "2024-03-01"},
+		{"15 июня 2024", "D MMM YYYY", "2024-06-15"},
+		{"5 января 2024 г.", "LL", "2024-01-05"},
+		{"Пятница, 5 января 2024 г., 15:25", "LLLL", "2024-01-05"},
+	}
+
+	for _, test := range tests {
+		lib, err := New(test.date, test.format, "ru")
+		assert.Nil(err, test.date)
+		assert.Equal(test.expected, lib.Format("YYYY-MM-DD"), test.date)
+	}
+
+	lib := simpleString("2024-01-01")
+	lib.SetLocale("ru")
+	assert.Equal(3, lib.SetDay("среду").Day())
+	assert.Equal(3, lib.SetDay("Среда").Day())
+}
+
+func TestRuCalendarWeek(t *testing.T) {
+	assert := assert.New(t)
+
+	testTime := time.Date(2000, 12, 15, 12, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time {
+		return testTime
+	}
+
+	SetLocale("ru")
+
+	assert.Equal("В прошлую среду, в 12:00", simpleTime(testTime).Subtract(2, "d").Calendar())
+	assert.Equal("В прошлый вторник, в 12:00", simpleTime(testTime).Subtract(3, "d").Calendar())
+	assert.Equal("В следующее воскресенье, в 12:00", simpleTime(testTime).Add(2, "d").Calendar())
+	assert.Equal("В следующую среду, в 12:00", simpleTime(testTime).Add(5, "d").Calendar())
+
+	// Reset timeNow.
+	timeNow = time.Now
+
+	SetLocale("en")
+}
+
+func TestRuRelativeTime(t *testing.T) {
+	assert := assert.New(t)
+
+	testTime := time.Date(2007, 1, 28, 0, 0, 0, 0, chicagoLocation())
+	lib := simpleTime(testTime)
+
+	lib.SetLocale("ru")
+
+	assert.Equal("несколько секунд назад", lib.From(simpleTime(testTime).Add(44, "s")), "44 seconds = a few seconds ago")
+	assert.Equal("минуту назад", lib.From(simpleTime(testTime).Add(1, "m")), "1 minute = a minute ago")
+	assert.Equal("минута", lib.From(simpleTime(testTime).Add(1, "m"), true), "1 minute = a minute")
+	assert.Equal("через 21 минуту", lib.To(simpleTime(testTime).Add(21, "m")), "21 minutes = in 21 minutes")
+	assert.Equal("21 минута", lib.From(simpleTime(testTime).Add(21, "m"), true), "21 minutes = 21 minutes")
+	assert.Equal("22 минуты назад", lib.From(simpleTime(testTime).Add(22, "m")), "22 minutes = 22 minutes ago")
+	assert.Equal("5 минут назад", lib.From(simpleTime(testTime).Add(5, "m")), "5 minutes = 5 minutes ago")
+	assert.Equal("11 минут", lib.From(simpleTime(testTime).Add(11, "m"), true), "11 minutes = 11 minutes")
+	assert.Equal("2 часа назад", lib.From(simpleTime(testTime).Add(2, "h")), "2 hours = 2 hours ago")
+	assert.Equal("5 часов назад", lib.From(simpleTime(testTime).Add(5, "h")), "5 hours = 5 hours ago")
+	assert.Equal("21 час назад", lib.From(simpleTime(testTime).Add(21, "h")), "21 hours = 21 hours ago")
+	assert.Equal("2 дня назад", lib.From(simpleTime(testTime).Add(2, "d")), "2 days = 2 days ago")
+	assert.Equal("21 день назад", lib.From(simpleTime(testTime).Add(21, "d")), "21 days = 21 days ago")
+	assert.Equal("25 дней назад", lib.From(simpleTime(testTime).Add(25, "d")), "25 days = 25 days ago")
+	assert.Equal("3 месяца назад", lib.From(simpleTime(testTime).Add(3, "M")), "3 months = 3 months ago")
+	assert.Equal("5 месяцев назад", lib.From(simpleTime(testTime).Add(5, "M")), "5 months = 5 months ago")
+	assert.Equal("2 года назад", lib.From(simpleTime(testTime).Add(2, "y")), "2 years = 2 years ago")
+	assert.Equal("5 лет назад", lib.From(simpleTime(testTime).Add(5, "y")), "5 years = 5 years ago")
+	assert.Equal("21 год назад", lib.From(simpleTime(testTime).Add(21, "y")), "21 years = 21 years ago")
+
+	weeks := RelativeTimeOptions{Thresholds: map[string]int{"w": 4, "d": 7}}
+	assert.Equal("через неделю", lib.To(simpleTime(testTime).Add(1, "w"), weeks), "1 week = in a week")
+	assert.Equal("неделя", lib.To(simpleTime(testTime).Add(1, "w"), true, weeks), "1 week = a week")
+	assert.Equal("3 недели назад", lib.From(simpleTime(testTime).Add(3, "w"), weeks), "3 weeks = 3 weeks ago")
+
+	seconds := RelativeTimeOptions{Thresholds: map[string]int{"ss": 0}}
+	assert.Equal("21 секунду назад", lib.From(simpleTime(testTime).Add(21, "s"), seconds), "21 seconds = 21 seconds ago")
+	assert.Equal("21 секунда", lib.From(simpleTime(testTime).Add(21, "s"), true, seconds), "21 seconds = 21 seconds")
+	assert.Equal("44 секунды назад", lib.From(simpleTime(testTime).Add(44, "s"), seconds), "44 seconds = 44 seconds ago")
+}
+
+func TestArLocale(t *testing.T) {
+	assert := assert.New(t)
+
+	longDays := []string{"الأحد", "الإثنين", "الثلاثاء", "الأربعاء", "الخميس", "الجمعة", "السبت"}
+	shortDays := []string{"أحد", "إثنين", "ثلاثاء", "أربعاء", "خميس", "جمعة", "سبت"}
+	minDays := []string{"ح", "ن", "ث", "ر", "خ", "ج", "س"}
+	longMonths := []string{"يناير", "فبراير", "مارس", "أبريل", "مايو", "يونيو", "يوليو", "أغسطس", "سبتمبر", "أكتوبر", "نوفمبر", "ديسمبر"}
+
+	lib := simpleNow()
+	lib.SetLocale("ar")
+
+	assert.Equal("ar", lib.Locale())
+	assert.Equal(longDays, lib.Weekdays())
+	assert.Equal(shortDays, lib.WeekdaysShort())
+	assert.Equal(minDays, lib.WeekdaysMin())
+	assert.Equal(longMonths, lib.Months())
+	assert.Equal(longMonths, lib.MonthsShort())
+}
+
+func TestArFormat(t *testing.T) {
+	assert := assert.New(t)
+
+	lib := simpleTime(time.Date(2024, 3, 11, 15, 25, 50, 0, time.UTC))
+	lib.SetLocale("ar")
+
+	assert.Equal("الإثنين 11 مارس 2024 15:25", lib.Format("LLLL"))
+	assert.Equal("11/3/2024", lib.Format("L"))
+	assert.Equal("3:25 م", lib.Format("h:mm a"))
+	assert.Equal("1 رمضان 1445", lib.Format("iD iMMMM iYYYY"))
+
+	parsed, err := New("15 ذو الحجة 1445", "iD iMMMM iYYYY", "ar")
+	assert.NoError(err)
+	assert.Equal("2024-06-22", parsed.Format("YYYY-MM-DD"))
+}
+
+func TestArRelativeTime(t *testing.T) {
+	assert := assert.New(t)
+
+	testTime := time.Date(2007, 1, 28, 0, 0, 0, 0, chicagoLocation())
+	lib := simpleTime(testTime)
+
+	lib.SetLocale("ar")
+
+	assert.Equal("منذ ثوان", lib.From(simpleTime(testTime).Add(44, "s")), "44 seconds = a few seconds ago")
+	assert.Equal("بعد دقيقة واحدة", lib.To(simpleTime(testTime).Add(1, "m")), "1 minute = in a minute")
+	assert.Equal("دقيقتان", lib.From(simpleTime(testTime).Add(2, "m"), true), "2 minutes = 2 minutes")
+	assert.Equal("منذ دقيقتين", lib.From(simpleTime(testTime).Add(2, "m")), "2 minutes = 2 minutes ago")
+	assert.Equal("منذ 5 دقائق", lib.From(simpleTime(testTime).Add(5, "m")), "5 minutes = 5 minutes ago")
+	assert.Equal("منذ 11 دقيقة", lib.From(simpleTime(testTime).Add(11, "m")), "11 minutes = 11 minutes ago")
+	assert.Equal("بعد 3 أيام", lib.To(simpleTime(testTime).Add(3, "d")), "3 days = in 3 days")
+	assert.Equal("منذ 25 يومًا", lib.From(simpleTime(testTime).Add(25, "d")), "25 days = 25 days ago")
+	assert.Equal("منذ عامين", lib.From(simpleTime(testTime).Add(2, "y")), "2 years = 2 years ago")
+}
+
+func TestFaRelativeTimeSeconds(t *testing.T) {
+	assert := assert.New(t)
+
+	testTime := time.Date(2007, 1, 28, 0, 0, 0, 0, chicagoLocation())
+	lib := simpleTime(testTime)
+
+	lib.SetLocale("fa")
+
+	assert.Equal("چند ثانیه پیش", lib.From(simpleTime(testTime).Add(44, "s")), "44 seconds = a few seconds ago")
+	assert.Equal("در چند ثانیه", lib.To(simpleTime(testTime).Add(44, "s")), "44 seconds = in a few seconds")
+}
+
+func TestRelativeTimeSingularSecond(t *testing.T) {
+	assert := assert.New(t)
+
+	testTime := time.Date(2007, 1, 28, 0, 0, 0, 0, chicagoLocation())
+	seconds := RelativeTimeOptions{Thresholds: map[string]int{"ss": -1}}
+
+	tests := map[string][]string{
+		"en":    {"0 seconds", "1 second", "2 seconds"},
+		"es":    {"0 segundos", "1 segundo", "2 segundos"},
+		"fr":    {"0 seconde", "1 seconde", "2 secondes"},
+		"pt-br": {"0 segundo", "1 segundo", "2 segundos"},
+		"id":    {"0 detik", "1 detik", "2 detik"},
+		"fa":    {"0 ثانیه", "1 ثانیه", "2 ثانیه"},
+		"ru":    {"0 секунд", "1 секунда", "2 секунды"},
+		"ar":    {"0 ثانية", "ثانية واحدة", "ثانيتان"},
+	}
+
+	for code, expected := range tests {
+		lib := simpleTime(testTime)
+		assert.Nil(lib.SetLocale(code))
+
+		actual := []string{}
+		for n := 0; n <= 2; n++ {
+			actual = append(actual, lib.From(simpleTime(testTime).Add(n, "s"), true, seconds))
+		}
+		assert.Equal(expected, actual, code)
+	}
+}
+
+func TestPluralCategory(t *testing.T) {
+	assert := assert.New(t)
+
+	categories := func(code string, numbers ...int) []string {
+		locale := loadKnownLocale(code)
+		c := []string{}
+		for _, n := range numbers {
+			c = append(c, locale.PluralCategory(n))
+		}
+		return c
+	}
+
+	assert.Equal([]string{"other", "one", "other"}, categories("en", 0, 1, 2))
+	assert.Equal([]string{"one", "one", "other"}, categories("fr", 0, 1, 2))
+	assert.Equal([]string{"other", "other"}, categories("id", 1, 2))
+	assert.Equal([]string{"many", "one", "few", "many", "many", "one", "few", "many", "many", "one"}, categories("ru", 0, 1, 2, 5, 11, 21, 22, 25, 112, 101))
+	assert.Equal([]string{"zero", "one", "two", "few", "many", "other", "few"}, categories("ar", 0, 1, 2, 10, 11, 100, 103))
+}
@@ -0,0 +1,112 @@
+package locales
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ArLocale is the Arabic language locale.
+var ArLocale = newLocale(
+	"ar",
+	strings.Split("الأحد_الإثنين_الثلاثاء_الأربعاء_الخميس_الجمعة_السبت", "_"),
+	strings.Split("أحد_إثنين_ثلاثاء_أربعاء_خميس_جمعة_سبت", "_"),
+	strings.Split("ح_ن_ث_ر_خ_ج_س", "_"),
+	strings.Split("يناير_فبراير_مارس_أبريل_مايو_يونيو_يوليو_أغسطس_سبتمبر_أكتوبر_نوفمبر_ديسمبر", "_"),
+	strings.Split("يناير_فبراير_مارس_أبريل_مايو_يونيو_يوليو_أغسطس_سبتمبر_أكتوبر_نوفمبر_ديسمبر", "_"),
+	func(num int, period string) string {
+		return fmt.Sprintf("%d", num)
+	},
+	func(hours int, minutes int, isLower bool) string {
+		if hours < 12 {
+			return "ص"
+		}
+		return "م"
+	},
+	week{Dow: 6, Doy: 12},
+	longDateFormats{
+		"LTS":  "HH:mm:ss",
+		"LT":   "HH:mm",
+		"L":    "D/M/YYYY",
+		"LL":   "D MMMM YYYY",
+		"LLL":  "D MMMM YYYY HH:mm",
+		"LLLL": "dddd D MMMM YYYY HH:mm",
+	},
+	relativeTimeFormats{
+		"future": "بعد %s",
+		"past":   "منذ %s",
+		"s":      "ثوان",
+		"ss":     arRelativeTime("ثانية واحدة_ثانيتان_ثانيتين_%d ثوان_%d ثانية_%d ثانية"),
+		"m":      "دقيقة واحدة",
+		"mm":     arRelativeTime("دقيقة واحدة_دقيقتان_دقيقتين_%d دقائق_%d دقيقة_%d دقيقة"),
+		"h":      "ساعة واحدة",
+		"hh":     arRelativeTime("ساعة واحدة_ساعتان_ساعتين_%d ساعات_%d ساعة_%d ساعة"),
+		"d":      "يوم واحد",
+		"dd":     arRelativeTime("يوم واحد_يومان_يومين_%d أيام_%d يومًا_%d يوم"),
+		"w":      "أسبوع واحد",
+		"ww":     arRelativeTime("أسبوع واحد_أسبوعان_أسبوعين_%d أسابيع_%d أسبوعًا_%d أسبوع"),
+		"M":      "شهر واحد",
+		"MM":     arRelativeTime("شهر واحد_شهران_شهرين_%d أشهر_%d شهرا_%d شهر"),
+		"y":      "عام واحد",
+		"yy":     arRelativeTime("عام واحد_عامان_عامين_%d أعوام_%d عامًا_%d عام"),
+	},
+	pluralArabic,
+	listFormats{
+		"separator":     "، ",
+		"lastSeparator": " و",
+	},
+	formatNames{},
+	calendarNames{
+		HijriMonths:      strings.Split("محرم_صفر_ربيع الأول_ربيع الآخر_جمادى الأولى_جمادى الآخرة_رجب_شعبان_رمضان_شوال_ذو القعدة_ذو الحجة", "_"),
+		HijriMonthsShort: strings.Split("محرم_صفر_ربيع الأول_ربيع الآخر_جمادى الأولى_جمادى الآخرة_رجب_شعبان_رمضان_شوال_ذو القعدة_ذو الحجة", "_"),
+	},
+	calendarFunctions{
+		"sameDay": func(hours int, day int) string {
+			return "[اليوم عند الساعة] LT"
+		},
+		"nextDay": func(hours int, day int) string {
+			return "[غدًا عند الساعة] LT"
+		},
+		"nextWeek": func(hours int, day int) string {
+			return "dddd [عند الساعة] LT"
+		},
+		"lastDay": func(hours int, day int) string {
+			return "[أمس عند الساعة] LT"
+		},
+		"lastWeek": func(hours int, day int) string {
+			return "dddd [عند الساعة] LT"
+		},
+		"sameElse": func(hours int, day int) string {
+			return "L"
+		},
+	},
+	`(يناير|فبراير|مارس|أبريل|مايو|يونيو|يوليو|أغسطس|سبتمبر|أكتوبر|نوفمبر|ديسمبر)`,
+	`(يناير|فبراير|مارس|أبريل|مايو|يونيو|يوليو|أغسطس|سبتمبر|أكتوبر|نوفمبر|ديسمبر)`,
+	`(الأحد|الإثنين|الثلاثاء|الأربعاء|الخميس|الجمعة|السبت)`,
+	`(أحد|إثنين|ثلاثاء|أربعاء|خميس|جمعة|سبت)`,
+	`(ح|ن|ث|ر|خ|ج|س)`,
+	`\d{1,2}`,
+)
+
+// arRelativeTime returns a relative time with forms for the one, two, few, many & other plural categories separated by
+// underscores. The two category has a form without a suffix and a form with one, like "يومان" & "بعد يومين".
+func arRelativeTime(forms string) relativeTimeFunction {
+	f := strings.Split(forms, "_")
+	return func(number int, withoutSuffix bool, key string, future bool) string {
+		format := f[5]
+		switch pluralArabic(number) {
+		case PluralOne:
+			format = f[0]
+		case PluralTwo:
+			format = f[2]
+			if withoutSuffix {
+				format = f[1]
+			}
+		case PluralFew:
+			format = f[3]
+		case PluralMany:
+			format = f[4]
+		}
+		return strings.Replace(format, "%d", strconv.Itoa(number), 1)
+	}
+}
@@ -45,18 +45,27 @@ var EnLocale = newLocale(
 		"future": "in %s",
 		"past":   "%s ago",
 		"s":      "a few seconds",
-		"ss":     "%d seconds",
+		"ss":     relativeTimePlurals{PluralOne: "%d second", PluralOther: "%d seconds"},
 		"m":      "a minute",
 		"mm":     "%d minutes",
 		"h":      "an hour",
 		"hh":     "%d hours",
 		"d":      "a day",
 		"dd":     "%d days",
+		"w":      "a week",
+		"ww":     "%d weeks",
 		"M":      "a month",
 		"MM":     "%d months",
 		"y":      "a year",
 		"yy":     "%d years",
 	},
+	pluralOneOther,
+	listFormats{
+		"separator":     ", ",
+		"lastSeparator": " and ",
+	},
+	formatNames{},
+	calendarNames{},
 	calendarFunctions{
 		"sameDay": func(hours int, day int) string {
 			return "[Today at] LT"
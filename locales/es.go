@@ -37,18 +37,27 @@ var EsLocale = newLocale(
 		"future": "en %s",
 		"past":   "hace %s",
 		"s":      "unos segundos",
-		"ss":     "%d segundos",
+		"ss":     relativeTimePlurals{PluralOne: "%d segundo", PluralOther: "%d segundos"},
 		"m":      "un minuto",
 		"mm":     "%d minutos",
 		"h":      "una hora",
 		"hh":     "%d horas",
 		"d":      "un día",
 		"dd":     "%d días",
+		"w":      "una semana",
+		"ww":     "%d semanas",
 		"M":      "un mes",
 		"MM":     "%d meses",
 		"y":      "un año",
 		"yy":     "%d años",
 	},
+	pluralOneOther,
+	listFormats{
+		"separator":     ", ",
+		"lastSeparator": " y ",
+	},
+	formatNames{},
+	calendarNames{},
 	calendarFunctions{
 		"sameDay": func(hours int, day int) string {
 			return "[hoy a " + getEsCalendarPronoun(hours) + "] LT"
@@ -29,7 +29,7 @@ var FaLocale = newLocale(
 	relativeTimeFormats{
 		"future": "در %s",
 		"past":   "%s پیش",
-		"s":      "چند ثانیه پیش",
+		"s":      "چند ثانیه",
 		"ss":     "%d ثانیه",
 		"m":      "یک دقیقه",
 		"mm":     "%d دقیقه",
@@ -37,11 +37,25 @@ var FaLocale = newLocale(
 		"hh":     "%d ساعت",
 		"d":      "یک روز",
 		"dd":     "%d روز",
+		"w":      "یک هفته",
+		"ww":     "%d هفته",
 		"M":      "یک ماه",
 		"MM":     "%d ماه",
 		"y":      "یک سال",
 		"yy":     "%d سال",
 	},
+	pluralZeroOneOther,
+	listFormats{
+		"separator":     "، ",
+		"lastSeparator": " و ",
+	},
+	formatNames{},
+	calendarNames{
+		JalaliMonths:      strings.Split("فروردین_اردیبهشت_خرداد_تیر_مرداد_شهریور_مهر_آبان_آذر_دی_بهمن_اسفند", "_"),
+		JalaliMonthsShort: strings.Split("فروردین_اردیبهشت_خرداد_تیر_مرداد_شهریور_مهر_آبان_آذر_دی_بهمن_اسفند", "_"),
+		HijriMonths:       strings.Split("محرم_صفر_ربیع‌الاول_ربیع‌الثانی_جمادی‌الاول_جمادی‌الثانی_رجب_شعبان_رمضان_شوال_ذی‌القعده_ذی‌الحجه", "_"),
+		HijriMonthsShort:  strings.Split("محرم_صفر_ربیع‌الاول_ربیع‌الثانی_جمادی‌الاول_جمادی‌الثانی_رجب_شعبان_رمضان_شوال_ذی‌القعده_ذی‌الحجه", "_"),
+	},
 	calendarFunctions{
 		"sameDay": func(hours int, day int) string {
 			return "[امروز] LT"
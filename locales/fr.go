@@ -53,18 +53,27 @@ var FrLocale = newLocale(
 		"future": "dans %s",
 		"past":   "il y a %s",
 		"s":      "quelques secondes",
-		"ss":     "%d secondes",
+		"ss":     relativeTimePlurals{PluralOne: "%d seconde", PluralOther: "%d secondes"},
 		"m":      "une minute",
 		"mm":     "%d minutes",
 		"h":      "une heure",
 		"hh":     "%d heures",
 		"d":      "un jour",
 		"dd":     "%d jours",
+		"w":      "une semaine",
+		"ww":     "%d semaines",
 		"M":      "un mois",
 		"MM":     "%d mois",
 		"y":      "un an",
 		"yy":     "%d ans",
 	},
+	pluralZeroOneOther,
+	listFormats{
+		"separator":     ", ",
+		"lastSeparator": " et ",
+	},
+	formatNames{},
+	calendarNames{},
 	calendarFunctions{
 		"sameDay": func(hours int, day int) string {
 			return "[Aujourd’hui à] LT"
@@ -58,11 +58,20 @@ var IdLocale = newLocale(
 		"hh":     "%d jam",
 		"d":      "sehari",
 		"dd":     "%d hari",
+		"w":      "seminggu",
+		"ww":     "%d minggu",
 		"M":      "sebulan",
 		"MM":     "%d bulan",
 		"y":      "setahun",
 		"yy":     "%d tahun",
 	},
+	pluralOther,
+	listFormats{
+		"separator":     ", ",
+		"lastSeparator": " dan ",
+	},
+	formatNames{},
+	calendarNames{},
 	calendarFunctions{
 		"sameDay": func(hours int, day int) string {
 			return "[hari ini pukul] LT"
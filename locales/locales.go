@@ -2,6 +2,7 @@ package locales
 
 import (
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -16,7 +17,48 @@ type calendarFunction func(int, int) string
 
 type longDateFormats map[string]string
 
-type relativeTimeFormats map[string]string
+// The CLDR plural categories, which decide the form of a word used with a number.
+const (
+	PluralZero  = "zero"
+	PluralOne   = "one"
+	PluralTwo   = "two"
+	PluralFew   = "few"
+	PluralMany  = "many"
+	PluralOther = "other"
+)
+
+type pluralFunction func(int) string
+
+// relativeTimeFormats holds a string, a relativeTimePlurals or a relativeTimeFunction for each relative time.
+type relativeTimeFormats map[string]interface{}
+
+// relativeTimePlurals is a relative time string for each plural category. The other category is used for missing
+// categories.
+type relativeTimePlurals map[string]string
+
+// relativeTimeFunction returns a relative time for a number, used when the string also depends on the suffix.
+type relativeTimeFunction func(number int, withoutSuffix bool, key string, future bool) string
+
+type listFormats map[string]string
+
+// formatNames are the month & weekday names used inside a date, for languages where they differ from the names used
+// alone, like the genitive "5 января" & the standalone "Январь" in Russian. Missing names use the standalone names.
+type formatNames struct {
+	Months      []string
+	MonthsShort []string
+	Weekdays    []string
+	// WeekdaysIsFormat matches the formats that use the weekday names, like "[В прошлую] dddd".
+	WeekdaysIsFormat string
+}
+
+// calendarNames are the month names of calendars other than the Gregorian calendar. Missing names use the English
+// names.
+type calendarNames struct {
+	JalaliMonths      []string
+	JalaliMonthsShort []string
+	HijriMonths       []string
+	HijriMonthsShort  []string
+}
 
 type calendarFunctions map[string]calendarFunction
 
@@ -33,11 +75,20 @@ type LocaleDetails struct {
 	WeekdaysShort          []string
 	Months                 []string
 	MonthsShort            []string
+	MonthsFormat           []string
+	MonthsShortFormat      []string
+	WeekdaysFormat         []string
+	JalaliMonths           []string
+	JalaliMonthsShort      []string
+	HijriMonths            []string
+	HijriMonthsShort       []string
 	OrdinalFunc            ordinalFunction
 	MeridiemFunc           meridiemFunction
+	PluralFunc             pluralFunction
 	Week                   week
 	LongDateFormats        longDateFormats
 	RelativeTimes          relativeTimeFormats
+	ListFormats            listFormats
 	Calendar               calendarFunctions
 	MonthsRegex            *regexp.Regexp
 	MonthsShortRegex       *regexp.Regexp
@@ -45,24 +96,63 @@ type LocaleDetails struct {
 	WeekdaysShortRegex     *regexp.Regexp
 	WeekdaysMinRegex       *regexp.Regexp
 	DayOfMonthOrdinalRegex *regexp.Regexp
+	WeekdaysIsFormatRegex  *regexp.Regexp
+	JalaliMonthsRegex      *regexp.Regexp
+	HijriMonthsRegex       *regexp.Regexp
 }
 
 // RelativeTime returns the relative time for the period.
 func (ld *LocaleDetails) RelativeTime(format string, number int, withoutSuffix bool, past bool) string {
-	relTime := strings.Replace(ld.RelativeTimes[format], "%d", strconv.Itoa(number), 1)
+	relTime := ld.relativeTime(format, number, withoutSuffix, !past)
 
 	if withoutSuffix {
 		return relTime
 	}
 
-	futurePast := ld.RelativeTimes["future"]
+	futurePast := ld.relativeTime("future", number, withoutSuffix, !past)
 	if past {
-		futurePast = ld.RelativeTimes["past"]
+		futurePast = ld.relativeTime("past", number, withoutSuffix, !past)
 	}
 
 	return strings.Replace(futurePast, "%s", relTime, 1)
 }
 
+// PluralCategory returns the CLDR plural category of a number, like one or few.
+func (ld *LocaleDetails) PluralCategory(number int) string {
+	if ld.PluralFunc == nil {
+		return pluralOneOther(number)
+	}
+	return ld.PluralFunc(number)
+}
+
+func (ld *LocaleDetails) relativeTime(key string, number int, withoutSuffix bool, future bool) string {
+	var format string
+
+	switch v := ld.RelativeTimes[key].(type) {
+	case string:
+		format = v
+	case relativeTimePlurals:
+		var ok bool
+		if format, ok = v[ld.PluralCategory(number)]; !ok {
+			format = v[PluralOther]
+		}
+	case relativeTimeFunction:
+		return v(number, withoutSuffix, key, future)
+	}
+
+	return strings.Replace(format, "%d", strconv.Itoa(number), 1)
+}
+
+// List joins items into a list, like "a, b and c".
+func (ld *LocaleDetails) List(items []string) string {
+	if len(items) <= 1 {
+		return strings.Join(items, "")
+	}
+
+	last := len(items) - 1
+	return strings.Join(items[:last], ld.ListFormats["separator"]) + ld.ListFormats["lastSeparator"] + items[last]
+}
+
 // LongDateFormat returns the format for the matching long date token.
 func (ld *LocaleDetails) LongDateFormat(key string) (string, bool) {
 	format, formatOk := ld.LongDateFormats[key]
@@ -89,40 +179,55 @@ func (ld *LocaleDetails) LongDateFormat(key string) (string, bool) {
 	return ld.LongDateFormats[key], true
 }
 
-// GetMonthNumber returns the number for the month name.
-func (ld *LocaleDetails) GetMonthNumber(month string) int {
-	var idx = 1
-	for _, s := range ld.Months {
-		if strings.ToLower(month) == strings.ToLower(s) {
-			return idx
+// ForFormat returns the locale with the month & weekday names used by a format, and whether they changed. Months
+// after a day of the month, like "D MMMM", and weekdays matched by the locale's WeekdaysIsFormatRegex use the format
+// names.
+func (ld *LocaleDetails) ForFormat(format string) (LocaleDetails, bool) {
+	locale := *ld
+	changed := false
+
+	if regexps.MonthsIsFormatRegex.MatchString(format) {
+		if len(ld.MonthsFormat) > 0 {
+			locale.Months = ld.MonthsFormat
+			changed = true
+		}
+		if len(ld.MonthsShortFormat) > 0 {
+			locale.MonthsShort = ld.MonthsShortFormat
+			changed = true
 		}
-		idx = idx + 1
 	}
-	return -1
+
+	if len(ld.WeekdaysFormat) > 0 && ld.WeekdaysIsFormatRegex != nil && ld.WeekdaysIsFormatRegex.MatchString(format) {
+		locale.Weekdays = ld.WeekdaysFormat
+		changed = true
+	}
+
+	return locale, changed
+}
+
+// GetMonthNumber returns the number for the month name, which can be the standalone or format name.
+func (ld *LocaleDetails) GetMonthNumber(month string) int {
+	return findName(month, 1, ld.Months, ld.MonthsFormat)
 }
 
-// GetMonthShortNumber returns the number for the short month name.
+// GetMonthShortNumber returns the number for the short month name, which can be the standalone or format name.
 func (ld *LocaleDetails) GetMonthShortNumber(month string) int {
-	var idx = 1
-	for _, s := range ld.MonthsShort {
-		if strings.ToLower(month) == strings.ToLower(s) {
-			return idx
-		}
-		idx = idx + 1
-	}
-	return -1
+	return findName(month, 1, ld.MonthsShort, ld.MonthsShortFormat)
 }
 
-// GetWeekdayNumber returns the number for the weekday name.
+// GetJalaliMonthNumber returns the number for the Jalali month name, which can be the full or short name.
+func (ld *LocaleDetails) GetJalaliMonthNumber(month string) int {
+	return findName(month, 1, ld.JalaliMonths, ld.JalaliMonthsShort)
+}
+
+// GetHijriMonthNumber returns the number for the Hijri month name, which can be the full or short name.
+func (ld *LocaleDetails) GetHijriMonthNumber(month string) int {
+	return findName(month, 1, ld.HijriMonths, ld.HijriMonthsShort)
+}
+
+// GetWeekdayNumber returns the number for the weekday name, which can be the standalone or format name.
 func (ld *LocaleDetails) GetWeekdayNumber(wd string) int {
-	var idx = 0
-	for _, s := range ld.Weekdays {
-		if strings.ToLower(wd) == strings.ToLower(s) {
-			return idx
-		}
-		idx = idx + 1
-	}
-	return -1
+	return findName(wd, 0, ld.Weekdays, ld.WeekdaysFormat)
 }
 
 // GetWeekdayShortNumber returns the number for the short weekday name.
@@ -179,6 +284,33 @@ func (ld *LocaleDetails) GetWeekdaysMin(shifted bool) []string {
 	return append(ld.WeekdaysMin[dow:7], ld.WeekdaysMin[0:dow]...)
 }
 
+// namesRegex returns a case insensitive regex matching any of the names. Longer names are matched first, so a name
+// isn't matched by its abbreviation.
+func namesRegex(lists ...[]string) *regexp.Regexp {
+	names := []string{}
+	for _, list := range lists {
+		for _, name := range list {
+			names = append(names, regexp.QuoteMeta(name))
+		}
+	}
+	sort.SliceStable(names, func(i, j int) bool {
+		return len(names[i]) > len(names[j])
+	})
+	return regexp.MustCompile(`(?i)(` + strings.Join(names, "|") + `)`)
+}
+
+// findName returns the number of a name in any of the lists, counting from first, or -1 if it is not found.
+func findName(name string, first int, lists ...[]string) int {
+	for _, list := range lists {
+		for idx, s := range list {
+			if strings.ToLower(name) == strings.ToLower(s) {
+				return idx + first
+			}
+		}
+	}
+	return -1
+}
+
 func mapString(vs []string, f func(string) string) []string {
 	vsm := make([]string, len(vs))
 	for i, v := range vs {
@@ -188,7 +320,7 @@ func mapString(vs []string, f func(string) string) []string {
 }
 
 func newLocale(code string, wd []string, wds []string, wdm []string, m []string, ms []string, of ordinalFunction,
-	mf meridiemFunction, wk week, ld longDateFormats, rt relativeTimeFormats, cal calendarFunctions,
+	mf meridiemFunction, wk week, ld longDateFormats, rt relativeTimeFormats, pf pluralFunction, lf listFormats, fn formatNames, cn calendarNames, cal calendarFunctions,
 	monthsRegex string, monthsShortRegex string, weekdaysRegex string, weekdaysShortRegex string, weekdaysMinRegex string, domOrdinalRegex string) LocaleDetails {
 	if mf == nil {
 		mf = func(hours int, minutes int, isLower bool) string {
@@ -205,6 +337,24 @@ func newLocale(code string, wd []string, wds []string, wdm []string, m []string,
 		}
 	}
 
+	if cn.JalaliMonths == nil {
+		cn.JalaliMonths = jalaliMonthsEn
+	}
+	if cn.JalaliMonthsShort == nil {
+		cn.JalaliMonthsShort = jalaliMonthsShortEn
+	}
+	if cn.HijriMonths == nil {
+		cn.HijriMonths = hijriMonthsEn
+	}
+	if cn.HijriMonthsShort == nil {
+		cn.HijriMonthsShort = hijriMonthsShortEn
+	}
+
+	var weekdaysIsFormatRegex *regexp.Regexp
+	if fn.WeekdaysIsFormat != "" {
+		weekdaysIsFormatRegex = regexp.MustCompile(fn.WeekdaysIsFormat)
+	}
+
 	// TODO - build regexs for weekdays based off arrays of weekday names.
 	return LocaleDetails{
 		Code:                   code,
@@ -213,11 +363,20 @@ func newLocale(code string, wd []string, wds []string, wdm []string, m []string,
 		WeekdaysMin:            wdm,
 		Months:                 m,
 		MonthsShort:            ms,
+		MonthsFormat:           fn.Months,
+		MonthsShortFormat:      fn.MonthsShort,
+		WeekdaysFormat:         fn.Weekdays,
+		JalaliMonths:           cn.JalaliMonths,
+		JalaliMonthsShort:      cn.JalaliMonthsShort,
+		HijriMonths:            cn.HijriMonths,
+		HijriMonthsShort:       cn.HijriMonthsShort,
 		OrdinalFunc:            of,
 		MeridiemFunc:           mf,
+		PluralFunc:             pf,
 		Week:                   wk,
 		LongDateFormats:        ld,
 		RelativeTimes:          rt,
+		ListFormats:            lf,
 		Calendar:               cal,
 		MonthsRegex:            regexp.MustCompile(monthsRegex),
 		MonthsShortRegex:       regexp.MustCompile(monthsShortRegex),
@@ -225,5 +384,75 @@ func newLocale(code string, wd []string, wds []string, wdm []string, m []string,
 		WeekdaysShortRegex:     regexp.MustCompile(weekdaysShortRegex),
 		WeekdaysMinRegex:       regexp.MustCompile(weekdaysMinRegex),
 		DayOfMonthOrdinalRegex: regexp.MustCompile(domOrdinalRegex),
+		WeekdaysIsFormatRegex:  weekdaysIsFormatRegex,
+		JalaliMonthsRegex:      namesRegex(cn.JalaliMonths, cn.JalaliMonthsShort),
+		HijriMonthsRegex:       namesRegex(cn.HijriMonths, cn.HijriMonthsShort),
+	}
+}
+
+var jalaliMonthsEn = strings.Split("Farvardin_Ordibehesht_Khordaad_Tir_Amordaad_Shahrivar_Mehr_Aabaan_Aazar_Dey_Bahman_Esfand", "_")
+
+var jalaliMonthsShortEn = strings.Split("Far_Ord_Kho_Tir_Amo_Sha_Meh_Aab_Aaz_Dey_Bah_Esf", "_")
+
+var hijriMonthsEn = strings.Split("Muharram_Safar_Rabi' al-Awwal_Rabi' al-Thani_Jumada al-Ula_Jumada al-Akhirah_Rajab_Sha'ban_Ramadan_Shawwal_Dhu al-Qi'dah_Dhu al-Hijjah", "_")
+
+var hijriMonthsShortEn = strings.Split("Muh_Saf_Rab-I_Rab-II_Jum-I_Jum-II_Raj_Sha_Ram_Shw_Dhu-Q_Dhu-H", "_")
+
+// pluralOneOther is the plural rule for languages like English, where only 1 is singular.
+func pluralOneOther(number int) string {
+	if number == 1 {
+		return PluralOne
+	}
+	return PluralOther
+}
+
+// pluralZeroOneOther is the plural rule for languages like French, where 0 & 1 are singular.
+func pluralZeroOneOther(number int) string {
+	if number == 0 || number == 1 {
+		return PluralOne
+	}
+	return PluralOther
+}
+
+// pluralOther is the plural rule for languages like Indonesian, which do not change words for numbers.
+func pluralOther(number int) string {
+	return PluralOther
+}
+
+// pluralArabic is the plural rule for Arabic, which has words for every category.
+func pluralArabic(number int) string {
+	if number < 0 {
+		number = -number
+	}
+
+	switch mod100 := number % 100; {
+	case number == 0:
+		return PluralZero
+	case number == 1:
+		return PluralOne
+	case number == 2:
+		return PluralTwo
+	case mod100 >= 3 && mod100 <= 10:
+		return PluralFew
+	case mod100 >= 11:
+		return PluralMany
+	default:
+		return PluralOther
+	}
+}
+
+// pluralEastSlavic is the plural rule for languages like Russian.
+func pluralEastSlavic(number int) string {
+	if number < 0 {
+		number = -number
+	}
+
+	switch mod10, mod100 := number%10, number%100; {
+	case mod10 == 1 && mod100 != 11:
+		return PluralOne
+	case mod10 >= 2 && mod10 <= 4 && (mod100 < 12 || mod100 > 14):
+		return PluralFew
+	default:
+		return PluralMany
 	}
 }
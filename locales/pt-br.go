@@ -37,18 +37,27 @@ var PtBRLocale = newLocale(
 		"future": "em %s",
 		"past":   "há %s",
 		"s":      "alguns segundos",
-		"ss":     "%d segundos",
+		"ss":     relativeTimePlurals{PluralOne: "%d segundo", PluralOther: "%d segundos"},
 		"m":      "um minuto",
 		"mm":     "%d minutos",
 		"h":      "uma hora",
 		"hh":     "%d horas",
 		"d":      "um dia",
 		"dd":     "%d dias",
+		"w":      "uma semana",
+		"ww":     "%d semanas",
 		"M":      "um mês",
 		"MM":     "%d meses",
 		"y":      "um ano",
 		"yy":     "%d anos",
 	},
+	pluralZeroOneOther,
+	listFormats{
+		"separator":     ", ",
+		"lastSeparator": " e ",
+	},
+	formatNames{},
+	calendarNames{},
 	calendarFunctions{
 		"sameDay": func(hours int, day int) string {
 			return "[hoje " + getPtBRCalendarPronoun(hours) + "] LT"
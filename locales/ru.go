@@ -42,18 +42,32 @@ var RuLocale = newLocale(
 		"future": "через %s",
 		"past":   "%s назад",
 		"s":      "несколько секунд",
-		"ss":     "%d секунд",
-		"m":      "минуту",
-		"mm":     "%d минут",
+		"ss":     ruRelativeTime("секунда_секунды_секунд", "секунду_секунды_секунд"),
+		"m":      ruRelativeTime("минута", "минуту"),
+		"mm":     ruRelativeTime("минута_минуты_минут", "минуту_минуты_минут"),
 		"h":      "час",
-		"hh":     "%d часов",
+		"hh":     relativeTimePlurals{PluralOne: "%d час", PluralFew: "%d часа", PluralMany: "%d часов"},
 		"d":      "день",
-		"dd":     "%d дней",
+		"dd":     relativeTimePlurals{PluralOne: "%d день", PluralFew: "%d дня", PluralMany: "%d дней"},
+		"w":      ruRelativeTime("неделя", "неделю"),
+		"ww":     ruRelativeTime("неделя_недели_недель", "неделю_недели_недель"),
 		"M":      "месяц",
-		"MM":     "%d месяцев",
+		"MM":     relativeTimePlurals{PluralOne: "%d месяц", PluralFew: "%d месяца", PluralMany: "%d месяцев"},
 		"y":      "год",
-		"yy":     "%d года",
+		"yy":     relativeTimePlurals{PluralOne: "%d год", PluralFew: "%d года", PluralMany: "%d лет"},
 	},
+	pluralEastSlavic,
+	listFormats{
+		"separator":     ", ",
+		"lastSeparator": " и ",
+	},
+	formatNames{
+		Months:           strings.Split("января_февраля_марта_апреля_мая_июня_июля_августа_сентября_октября_ноября_декабря", "_"),
+		MonthsShort:      strings.Split("янв._февр._мар._апр._мая_июня_июля_авг._сент._окт._нояб._дек.", "_"),
+		Weekdays:         strings.Split("воскресенье_понедельник_вторник_среду_четверг_пятницу_субботу", "_"),
+		WeekdaysIsFormat: `\[ ?[Вв] ?(?:прошл\S*|следующ\S*|эту)? ?\] ?dddd`,
+	},
+	calendarNames{},
 	calendarFunctions{
 		"sameDay": func(hours int, day int) string {
 			return "[Сегодня в] LT"
@@ -62,22 +76,60 @@ var RuLocale = newLocale(
 			return "[Завтра в] LT"
 		},
 		"nextWeek": func(hours int, day int) string {
-			return "dddd [в сл.] LT"
+			return ruWeekdayPhrase(day, "[В следующее]", "[В следующий]", "[В следующую]") + " dddd, [в] LT"
 		},
 		"lastDay": func(hours int, day int) string {
 			return "[Вчера в] LT"
 		},
 		"lastWeek": func(hours int, day int) string {
-			return "[В прошлый] dddd [в] LT"
+			return ruWeekdayPhrase(day, "[В прошлое]", "[В прошлый]", "[В прошлую]") + " dddd, [в] LT"
 		},
 		"sameElse": func(hours int, day int) string {
 			return "L"
 		},
 	},
-	`(?i)(Январь|Февраль|Март|Апрель|Май|Июнь|Июль|Август|Сентябрь|Октябрь|Ноябрь|Декабрь)`,
-	`(?i)(Янв|Фев|Мар|Апр|Май|Июн|Июл|Авг|Сен|Окт|Ноя|Дек)`,
-	`(?i)(Воскресенье|Понедельник|Вторник|Среда|Четверг|Пятница|Суббота)`,
+	`(?i)(января|февраля|марта|апреля|мая|июня|июля|августа|сентября|октября|ноября|декабря|Январь|Февраль|Март|Апрель|Май|Июнь|Июль|Август|Сентябрь|Октябрь|Ноябрь|Декабрь)`,
+	`(?i)(янв\.|февр\.|мар\.|апр\.|мая|июня|июля|авг\.|сент\.|окт\.|нояб\.|дек\.|Янв|Фев|Мар|Апр|Май|Июн|Июл|Авг|Сен|Окт|Ноя|Дек)`,
+	`(?i)(среду|пятницу|субботу|Воскресенье|Понедельник|Вторник|Среда|Четверг|Пятница|Суббота)`,
 	`(?i)(Вос|Пон|Вто|Сре|Чет|Пят|Суб)`,
 	`(?i)(Вс|Пн|Вт|Ср|Чт|Пт|Сб)`,
 	`\d{1,2}(й|го|я)`,
 )
+
+// ruRelativeTime returns a relative time that uses the nominative case without a suffix & the accusative case with
+// one, like "21 минута" & "через 21 минуту". Words with a number have forms for the one, few & many plural categories
+// separated by underscores.
+func ruRelativeTime(nominative, accusative string) relativeTimeFunction {
+	return func(number int, withoutSuffix bool, key string, future bool) string {
+		forms := strings.Split(accusative, "_")
+		if withoutSuffix {
+			forms = strings.Split(nominative, "_")
+		}
+
+		if len(forms) == 1 {
+			return forms[0]
+		}
+
+		word := forms[2]
+		switch pluralEastSlavic(number) {
+		case PluralOne:
+			word = forms[0]
+		case PluralFew:
+			word = forms[1]
+		}
+		return strconv.Itoa(number) + " " + word
+	}
+}
+
+// ruWeekdayPhrase returns the phrase for the grammatical gender of the weekday, which is neuter for Sunday, masculine
+// for Monday, Tuesday & Thursday, and feminine for the rest.
+func ruWeekdayPhrase(day int, neuter, masculine, feminine string) string {
+	switch day {
+	case 0:
+		return neuter
+	case 1, 2, 4:
+		return masculine
+	default:
+		return feminine
+	}
+}
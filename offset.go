@@ -16,6 +16,23 @@ func (g *Goment) UTC() *Goment {
 	return g
 }
 
+// Tz will convert the Goment to the named IANA time zone, like "America/Chicago". The instant is unchanged, and
+// later manipulations follow the time zone's daylight saving rules. An unknown time zone is ignored.
+func (g *Goment) Tz(zone string) *Goment {
+	loc, err := time.LoadLocation(zone)
+	if err == nil {
+		g.time = g.ToTime().In(loc)
+	}
+	return g
+}
+
+// TimeZoneName gets the IANA name of the Goment's time zone, like "America/Chicago". Goments in the local
+// time zone return "Local" rather than the zone's abbreviation, and ones with a fixed UTC offset set by
+// SetUTCOffset return "Offset".
+func (g *Goment) TimeZoneName() string {
+	return g.ToTime().Location().String()
+}
+
 // UTCOffset get the UTC offset in minutes.
 func (g *Goment) UTCOffset() int {
 	_, o := g.ToTime().Zone()
@@ -34,3 +51,22 @@ func (g *Goment) SetUTCOffset(offset int) *Goment {
 
 	return g
 }
+
+// wallClockTime returns the time on the wall clock of a location. A time in a DST gap uses the UTC offset from
+// before the gap, so 2:30am on a day that skips from 2am to 3am becomes 3:30am. A time that is repeated when the
+// clocks go back is the first of the two.
+func wallClockTime(year int, month time.Month, day, hour, minute, second, nanosecond int, loc *time.Location) time.Time {
+	t := time.Date(year, month, day, hour, minute, second, nanosecond, loc)
+
+	// Using the UTC offset from a day earlier gives the time before any DST change.
+	wall := time.Date(year, month, day, hour, minute, second, nanosecond, time.UTC)
+	_, offset := wall.Add(-24 * time.Hour).In(loc).Zone()
+	earlier := wall.Add(-time.Duration(offset) * time.Second).In(loc)
+
+	inGap := t.Hour() != hour || t.Minute() != minute
+	repeated := earlier.Before(t) && earlier.Hour() == hour && earlier.Minute() == minute
+	if inGap || repeated {
+		return earlier
+	}
+	return t
+}
@@ -81,3 +81,41 @@ func TestSetUTCOffsetInMinutes(t *testing.T) {
 	lib.SetUTCOffset(-120)
 	assert.Equal(12, lib.Hour())
 }
+
+func TestTzConvertsTimeZone(t *testing.T) {
+	assert := assert.New(t)
+
+	lib := simpleTime(time.Date(2011, 5, 13, 14, 0, 0, 0, time.UTC))
+
+	lib.Tz("America/New_York")
+	assert.Equal("2011-05-13T10:00:00-04:00", lib.Format())
+	assert.Equal("America/New_York", lib.TimeZoneName())
+
+	lib.Tz("Not/AZone")
+	assert.Equal("America/New_York", lib.TimeZoneName())
+}
+
+func TestTimeZoneName(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("UTC", simpleTime(time.Date(2011, 5, 13, 14, 0, 0, 0, time.UTC)).TimeZoneName())
+	assert.Equal("America/Chicago", simpleTime(time.Date(2011, 5, 13, 14, 0, 0, 0, chicagoLocation())).TimeZoneName())
+	assert.Equal("Local", simpleTime(time.Date(2011, 5, 13, 14, 0, 0, 0, time.Local)).TimeZoneName())
+	assert.Equal("Offset", simpleTime(time.Date(2011, 5, 13, 14, 0, 0, 0, time.UTC)).SetUTCOffset(-5).TimeZoneName())
+}
+
+func TestTzManipulationAcrossDST(t *testing.T) {
+	assert := assert.New(t)
+
+	lib, _ := NewTz("America/Chicago", "2024-03-10T12:00:00")
+
+	assert.Equal("2024-03-10T00:00:00-06:00", lib.Clone().StartOf("day").Format())
+	assert.Equal("2024-03-10T23:59:59-05:00", lib.Clone().EndOf("day").Format())
+	assert.Equal("2024-03-11T12:00:00-05:00", lib.Clone().Add(1, "day").Format())
+	assert.Equal("2024-03-09T12:00:00-06:00", lib.Clone().Subtract(1, "day").Format())
+	assert.Equal("2024-03-09T11:00:00-06:00", lib.Clone().Subtract(24, "hours").Format())
+
+	lib, _ = NewTz("America/Chicago", "2024-11-03T12:00:00")
+	assert.Equal("2024-11-03T00:00:00-05:00", lib.Clone().StartOf("day").Format())
+	assert.Equal("2024-11-03T23:59:59-06:00", lib.Clone().EndOf("day").Format())
+}
@@ -2,6 +2,7 @@ package goment
 
 import (
 	"errors"
+	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
@@ -21,61 +22,57 @@ var nanosecondIdx = 6
 var weekIdx = 7
 var weekdayIdx = 8
 
+var overflowUnits = map[int]string{
+	yearIdx:       "year",
+	monthIdx:      "month",
+	dateIdx:       "date",
+	hourIdx:       "hour",
+	minuteIdx:     "minute",
+	secondIdx:     "second",
+	nanosecondIdx: "nanosecond",
+	weekIdx:       "week",
+	weekdayIdx:    "weekday",
+}
+
+// Offsets in minutes for the obsolete zone names allowed by RFC 2822.
+var rfc2822ZoneOffsets = map[string]int{
+	"UT":  0,
+	"GMT": 0,
+	"EDT": -4 * 60,
+	"EST": -5 * 60,
+	"CDT": -5 * 60,
+	"CST": -6 * 60,
+	"MDT": -6 * 60,
+	"MST": -7 * 60,
+	"PDT": -7 * 60,
+	"PST": -8 * 60,
+}
+
 var isoDates = []isoDateFormat{
-	// createISOFormat("+002006-01-02", `\+\d{6}-\d\d-\d\d`),
-	// createISOFormat("-002006-01-02", `-\d{6}-\d\d-\d\d`),
-	createISODateFormat("2006-01-02", `\d{4}-\d\d-\d\d`),
-	createISODateFormat("2006-01", `\d{4}-\d\d`, false),
-	createISODateFormat("20060102", `\d{8}`),
-}
-
-var isoTimes = []isoTimeFormat{
-	createISOTimeFormat("15:04:05.9999", `\d\d:\d\d:\d\d\.\d+`),
-	// createISOTimeFormat("15:04:05,9999", `\d\d:\d\d:\d\d,\d+`), // TODO - will need to replace the comma with a period in date string
-	createISOTimeFormat("15:04:05", `\d\d:\d\d:\d\d`),
-	createISOTimeFormat("15:04", `\d\d:\d\d`),
-	createISOTimeFormat("150405.9999", `\d\d\d\d\d\d\.\d+`),
-	// createISOTimeFormat("150405,9999", `\d\d\d\d\d\d,\d+`), // TODO - replace comma
-	createISOTimeFormat("150405", `\d\d\d\d\d\d`),
-	createISOTimeFormat("1504", `\d\d\d\d`),
-	createISOTimeFormat("15", `\d\d`),
-}
-
-// var isoDates = [
-//     ['YYYYYY-MM-DD', /[+-]\d{6}-\d\d-\d\d/],
-//     ['YYYY-MM-DD', /\d{4}-\d\d-\d\d/],
-//     ['GGGG-[W]WW-E', /\d{4}-W\d\d-\d/],
-//     ['GGGG-[W]WW', /\d{4}-W\d\d/, false],
-//     ['YYYY-DDD', /\d{4}-\d{3}/],
-//     ['YYYY-MM', /\d{4}-\d\d/, false],
-//     ['YYYYYYMMDD', /[+-]\d{10}/],
-//     ['YYYYMMDD', /\d{8}/],
-//     // YYYYMM is NOT allowed by the standard
-//     ['GGGG[W]WWE', /\d{4}W\d{3}/],
-//     ['GGGG[W]WW', /\d{4}W\d{2}/, false],
-//     ['YYYYDDD', /\d{7}/]
-// ]
-
-// var isoTimes = [
-//     ['HH:mm:ss.SSSS', /\d\d:\d\d:\d\d\.\d+/],
-//     ['HH:mm:ss,SSSS', /\d\d:\d\d:\d\d,\d+/],
-//     ['HH:mm:ss', /\d\d:\d\d:\d\d/],
-//     ['HH:mm', /\d\d:\d\d/],
-//     ['HHmmss.SSSS', /\d\d\d\d\d\d\.\d+/],
-//     ['HHmmss,SSSS', /\d\d\d\d\d\d,\d+/],
-//     ['HHmmss', /\d\d\d\d\d\d/],
-//     ['HHmm', /\d\d\d\d/],
-//     ['HH', /\d\d/]
-// ]
+	createISODateFormat("YYYYYY-MM-DD", `^[+-]\d{6}-\d\d-\d\d$`),
+	createISODateFormat("YYYY-MM-DD", `^\d{4}-\d\d-\d\d$`),
+	createISODateFormat("GGGGG-[W]WW-E", `^[+-]\d{6}-W\d\d-\d$`),
+	createISODateFormat("GGGG-[W]WW-E", `^\d{4}-W\d\d-\d$`),
+	createISODateFormat("GGGGG-[W]WW", `^[+-]\d{6}-W\d\d$`, false),
+	createISODateFormat("GGGG-[W]WW", `^\d{4}-W\d\d$`, false),
+	createISODateFormat("YYYYYY-DDDD", `^[+-]\d{6}-\d{3}$`),
+	createISODateFormat("YYYY-DDDD", `^\d{4}-\d{3}$`),
+	createISODateFormat("YYYYYY-MM", `^[+-]\d{6}-\d\d$`, false),
+	createISODateFormat("YYYY-MM", `^\d{4}-\d\d$`, false),
+	createISODateFormat("YYYYYYMMDD", `^[+-]\d{10}$`),
+	createISODateFormat("YYYYMMDD", `^\d{8}$`),
+	// YYYYMM is NOT allowed by the standard
+	createISODateFormat("GGGGG[W]WWE", `^[+-]\d{6}W\d{3}$`),
+	createISODateFormat("GGGG[W]WWE", `^\d{4}W\d{3}$`),
+	createISODateFormat("GGGGG[W]WW", `^[+-]\d{6}W\d\d$`, false),
+	createISODateFormat("GGGG[W]WW", `^\d{4}W\d\d$`, false),
+	createISODateFormat("YYYYYYDDDD", `^[+-]\d{9}$`),
+	createISODateFormat("YYYYDDDD", `^\d{7}$`),
+}
 
 type configFunc func(string, *parseConfig, locales.LocaleDetails, string)
 type findTokenFunc func(string, locales.LocaleDetails) (string, string)
 
-type isoTimeFormat struct {
-	format string
-	regex  *regexp.Regexp
-}
-
 type isoDateFormat struct {
 	format    string
 	regex     *regexp.Regexp
@@ -83,6 +80,7 @@ type isoDateFormat struct {
 }
 
 type parseConfig struct {
+	strict            bool
 	isUTC             bool
 	tzMinutes         int
 	dayOfYear         int
@@ -92,13 +90,19 @@ type parseConfig struct {
 	meridiem          string
 	week              map[string]int
 	parsedArray       map[int]int
+	calendar          calendarSystem
+	calendarDate      map[int]int
+	hijri             HijriCalendar
 	date              *Goment
+	location          *time.Location
 	locale            locales.LocaleDetails
+	flags             ParsingFlags
 }
 
 type parseReplacement struct {
-	configFunction    configFunc
-	findTokenFunction findTokenFunc
+	configFunction          configFunc
+	findTokenFunction       findTokenFunc
+	strictFindTokenFunction findTokenFunc
 }
 
 var parseReplacements = map[string]parseReplacement{}
@@ -108,10 +112,6 @@ func loadParseReplacements() {
 		return
 	}
 
-	// 	// // "S":    createParseReplacement("S", "frac_second", nil, nil),
-	// 	// // "SS":   createParseReplacement("SS", "frac_second", nil, nil),
-	// 	// // "SSS":  createParseReplacement("SSS", "frac_second", nil, nil),
-
 	addParseReplacement([]string{"D", "DD"}, dateIdx, regexps.MatchOneToTwo)
 	addParseReplacement("Do", handleOrdinalDate, func(input string, locale locales.LocaleDetails) (string, string) {
 		return findRegexString(input, locale.DayOfMonthOrdinalRegex)
@@ -127,6 +127,18 @@ func loadParseReplacements() {
 		return findRegexString(input, locale.MonthsRegex)
 	})
 
+	addParseReplacement([]string{"jYY", "jM", "jMM", "jD", "jDD"}, handleJalali, regexps.MatchOneToTwo)
+	addParseReplacement("jYYYY", handleJalali, regexps.MatchOneToFour)
+	addParseReplacement([]string{"jMMM", "jMMMM"}, handleJalali, func(input string, locale locales.LocaleDetails) (string, string) {
+		return findRegexString(input, locale.JalaliMonthsRegex)
+	})
+
+	addParseReplacement([]string{"iYY", "iM", "iMM", "iD", "iDD"}, handleHijri, regexps.MatchOneToTwo)
+	addParseReplacement("iYYYY", handleHijri, regexps.MatchOneToFour)
+	addParseReplacement([]string{"iMMM", "iMMMM"}, handleHijri, func(input string, locale locales.LocaleDetails) (string, string) {
+		return findRegexString(input, locale.HijriMonthsRegex)
+	})
+
 	addParseReplacement("Y", handleSingleDigitYear, regexps.MatchSigned)
 	addParseReplacement("YY", handleTwoDigitYear, regexps.MatchOneToTwo)
 	addParseReplacement("YYYY", handleFourDigitYear, regexps.MatchOneToFour)
@@ -142,6 +154,10 @@ func loadParseReplacements() {
 	addParseReplacement([]string{"s", "ss"}, secondIdx, regexps.MatchOneToTwo)
 	addParseReplacement([]string{"a", "A"}, handleMeridiem, regexps.MatchMeridiem)
 
+	// Fractional seconds, S to SSSSSSSSS.
+	addParseReplacement([]string{"S", "SS", "SSS"}, handleFractionalSecond, regexps.MatchOneToThree)
+	addParseReplacement([]string{"SSSS", "SSSSS", "SSSSSS", "SSSSSSS", "SSSSSSSS", "SSSSSSSSS"}, handleFractionalSecond, regexps.MatchUnsigned)
+
 	addParseReplacement([]string{"Z", "ZZ"}, handleOffset, regexps.MatchShortOffset)
 
 	// Week & weekday parsing
@@ -162,6 +178,14 @@ func loadParseReplacements() {
 	addWeekParseReplacement([]string{"gg", "GG"}, handleTwoDigitWeekYear, regexps.MatchOneToTwo)
 	addWeekParseReplacement([]string{"gggg", "GGGG"}, handleWeekYear, regexps.MatchOneToFour)
 	addWeekParseReplacement([]string{"ggggg", "GGGGG"}, handleWeekYear, regexps.MatchOneToSix)
+
+	// Fixed width tokens must consume exactly their width when parsing strictly.
+	addStrictParseReplacement([]string{"DD", "MM", "YY", "HH", "hh", "kk", "mm", "ss", "ww", "WW", "gg", "GG", "jYY", "jMM", "jDD", "iYY", "iMM", "iDD"}, regexps.MatchTwo)
+	addStrictParseReplacement([]string{"YYYY", "gggg", "GGGG", "jYYYY", "iYYYY"}, regexps.MatchFour)
+	addStrictParseReplacement([]string{"YYYYY", "YYYYYY", "ggggg", "GGGGG"}, regexps.MatchSix)
+	for i := 1; i <= 9; i++ {
+		addStrictParseReplacement([]string{strings.Repeat("S", i)}, regexp.MustCompile(fmt.Sprintf(`\d{%d}`, i)))
+	}
 }
 
 func findRegexString(input string, rx *regexp.Regexp) (string, string) {
@@ -233,6 +257,7 @@ func addWeekParseReplacement(args ...interface{}) {
 				cf(input, config, locale, token)
 			},
 			ftf,
+			ftf,
 		}
 	}
 }
@@ -258,15 +283,21 @@ func addParseReplacement(args ...interface{}) {
 			parseReplacements[t] = parseReplacement{
 				cf,
 				ftf,
+				ftf,
 			}
 		}
 	}
 }
 
-func createISOTimeFormat(format, regex string) isoTimeFormat {
-	return isoTimeFormat{
-		format,
-		regexp.MustCompile(regex),
+// addStrictParseReplacement overrides how the input for already registered tokens is found when parsing strictly.
+func addStrictParseReplacement(tokens []string, arg interface{}) {
+	ftf := buildFindTokenFunction(arg)
+
+	for _, t := range tokens {
+		if rep, ok := parseReplacements[t]; ok {
+			rep.strictFindTokenFunction = ftf
+			parseReplacements[t] = rep
+		}
 	}
 }
 
@@ -283,92 +314,225 @@ func createISODateFormat(format, regex string, allowTime ...bool) isoDateFormat
 	}
 }
 
-func parseFromFormat(date string, format string, locale locales.LocaleDetails) (time.Time, error) {
-	parsedDate, err := parseToGoment(date, format, locale)
-	if err != nil {
-		return time.Time{}, err
-	}
+// parseISOString parses an ISO 8601 date, using the location for dates without an offset.
+func parseISOString(date string, loc *time.Location) (time.Time, error) {
+	loadReplacements()
 
-	return parsedDate.ToTime(), nil
-}
-
-func parseISOString(date string) (time.Time, error) {
 	match := regexps.ExtendedISORegex.FindStringSubmatch(date)
 	if match == nil {
 		match = regexps.BasicISORegex.FindStringSubmatch(date)
 	}
 
-	allowTime := true
-	dateFormat, timeFormat, tzFormat := "", "", ""
+	if len(match) != 5 {
+		return time.Time{}, errors.New("Not a matching ISO-8601 date")
+	}
 
-	if len(match) == 5 {
-		// match[0] = matched
-		// match[1] = date
-		// match[2] = T
-		// match[3] = time
-		// match[4] = timezone
+	// match[0] = matched
+	// match[1] = date
+	// match[2] = T
+	// match[3] = time
+	// match[4] = timezone
+
+	var dateFormat *isoDateFormat
+	for i := range isoDates {
+		if isoDates[i].regex.MatchString(match[1]) {
+			dateFormat = &isoDates[i]
+			break
+		}
+	}
 
-		for _, i := range isoDates {
-			if i.regex.MatchString(match[1]) {
-				dateFormat = i.format
-				allowTime = i.allowTime
-				break
-			}
+	if dateFormat == nil {
+		return time.Time{}, errors.New("No matching date format found")
+	}
+
+	// The date part is parsed strictly with the Goment parser, which handles calendar, week & ordinal dates.
+	parsedDate, err := parseToGoment(match[1], dateFormat.format, getGlobalLocaleDetails(), true, time.UTC)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var hour, minute, second, nanosecond int
+	if match[3] != "" {
+		if !dateFormat.allowTime {
+			return time.Time{}, errors.New("Time part not allowed")
 		}
 
-		if dateFormat == "" {
-			return time.Time{}, errors.New("No matching date format found")
+		hour, minute, second, nanosecond, err = parseISOTime(match[3])
+		if err != nil {
+			return time.Time{}, err
 		}
+	}
 
-		if match[3] != "" {
-			for _, i := range isoTimes {
-				if i.regex.MatchString(match[3]) {
-					separator := match[2]
-					if separator == "" {
-						separator = " "
-					}
+	year, month, day := parsedDate.Year(), parsedDate.Month(), parsedDate.Date()
 
-					timeFormat = separator + i.format
-					break
-				}
-			}
+	if match[4] != "" {
+		timezoneMatch := regexps.TimeZoneRegex.FindString(match[4])
+		if timezoneMatch == "" {
+			return time.Time{}, errors.New("Invalid timezone format")
+		}
+		if timezoneMatch == "Z" {
+			loc = time.UTC
+		} else {
+			return dateWithOffset(year, month, day, hour, minute, second, nanosecond, offsetToMinutes(timezoneMatch)*60), nil
+		}
+	}
 
-			if timeFormat == "" {
-				return time.Time{}, errors.New("No matching time format found")
-			}
+	return time.Date(year, time.Month(month), day, hour, minute, second, nanosecond, loc), nil
+}
+
+func parseRFC2822String(date string) (time.Time, error) {
+	// Remove comments and fold whitespace before matching.
+	date = regexps.RFC2822CommentRegex.ReplaceAllString(date, " ")
+	date = strings.TrimSpace(regexps.WhitespaceRegex.ReplaceAllString(date, " "))
+
+	match := regexps.RFC2822Regex.FindStringSubmatch(date)
+	if match == nil {
+		return time.Time{}, errors.New("Not a matching RFC 2822 date")
+	}
+
+	// match[1] = weekday
+	// match[2] = day
+	// match[3] = month
+	// match[4] = year
+	// match[5] = hour
+	// match[6] = minute
+	// match[7] = second
+	// match[8] = obsolete zone name
+	// match[9] = military zone
+	// match[10] = offset
+
+	day := parseNumber(match[2])
+	month := locales.EnLocale.GetMonthShortNumber(match[3])
+	hour, minute, second := parseNumber(match[5]), parseNumber(match[6]), parseNumber(match[7])
+
+	// Obsolete two & three digit years are relative to 1900, except two digit years before 50.
+	year := parseNumber(match[4])
+	switch len(match[4]) {
+	case 2:
+		if year < 50 {
+			year += 2000
+		} else {
+			year += 1900
 		}
+	case 3:
+		year += 1900
+	}
 
-		if !allowTime && timeFormat != "" {
-			return time.Time{}, errors.New("Time part not allowed")
+	if day < 1 || day > daysInMonth(month, year) || hour > 23 || minute > 59 || second > 59 {
+		return time.Time{}, errors.New("RFC 2822 date has values out of range")
+	}
+
+	offset := 0
+	switch {
+	case match[8] != "":
+		offset = rfc2822ZoneOffsets[match[8]] * 60
+	case match[10] != "":
+		offset = offsetToMinutes(match[10]) * 60
+	}
+
+	// Military zones other than Z were defined with the wrong sign, so they are all treated as UTC.
+	t := time.Date(year, time.Month(month), day, hour, minute, second, 0, time.UTC)
+	if offset != 0 {
+		t = dateWithOffset(year, month, day, hour, minute, second, 0, offset)
+	}
+
+	if match[1] != "" && locales.EnLocale.GetWeekdayShortNumber(match[1]) != int(t.Weekday()) {
+		return time.Time{}, errors.New("There is a mismatch between parsed weekday and expected weekday")
+	}
+
+	return t, nil
+}
+
+// dateWithOffset creates a time in a fixed offset of seconds. Like time.Parse, the local location is used
+// if its offset matches at that time.
+func dateWithOffset(year, month, day, hour, minute, second, nanosecond, offset int) time.Time {
+	t := time.Date(year, time.Month(month), day, hour, minute, second, nanosecond, time.FixedZone("", offset))
+	if _, localOffset := t.In(time.Local).Zone(); localOffset == offset {
+		return t.In(time.Local)
+	}
+	return t
+}
+
+// parseISOTime parses the time of an ISO 8601 date, like 10:30:15.5 or 103015,5. The last of the hours, minutes &
+// seconds can have a decimal fraction, like 10.5 for 10:30, and 24:00 is midnight at the end of the day. The
+// nanoseconds hold the fraction, so they can be more than a second.
+func parseISOTime(value string) (int, int, int, int, error) {
+	fraction := ""
+	if i := strings.IndexAny(value, ".,"); i >= 0 {
+		value, fraction = value[:i], value[i+1:]
+	}
+	value = strings.Replace(value, ":", "", -1)
+
+	parts := []int{0, 0, 0}
+	for i := 0; i < len(value)/2; i++ {
+		parts[i] = parseNumber(value[i*2 : i*2+2])
+	}
+	hour, minute, second := parts[0], parts[1], parts[2]
+
+	// The fraction is of the last unit, which is worth a number of seconds.
+	nanosecond := 0
+	if fraction != "" {
+		if len(fraction) > 9 {
+			fraction = fraction[:9]
 		}
+		billionths, _ := strconv.Atoi(fraction + strings.Repeat("0", 9-len(fraction)))
+		nanosecond = billionths * []int{3600, 60, 1}[len(value)/2-1]
+	}
 
-		if match[4] != "" {
-			timezoneMatch := regexps.TimeZoneRegex.FindString(match[4])
-			if timezoneMatch == "Z" {
-				tzFormat = "Z"
-			} else if timezoneMatch != "" {
-				tzFormat = "-0700"
-			} else {
-				return time.Time{}, errors.New("Invalid timezone format")
+	if hour > 24 || minute > 59 || second > 59 || (hour == 24 && (minute != 0 || second != 0 || nanosecond != 0)) {
+		return 0, 0, 0, 0, errors.New("Time is out of range")
+	}
+	return hour, minute, second, nanosecond, nil
+}
+
+// parseToGomentFromFormats parses the date with each format, returning the best result. Valid results are
+// preferred over invalid ones, then the result with the lowest score wins, then the earliest format.
+func parseToGomentFromFormats(date string, formats []string, locale locales.LocaleDetails, strict bool, loc *time.Location) (*Goment, error) {
+	if len(formats) == 0 {
+		return nil, errors.New("No formats supplied")
+	}
+
+	var best *Goment
+	var bestErr, firstErr error
+	bestValid := false
+
+	for _, format := range formats {
+		g, err := parseToGoment(date, format, locale, strict, loc)
+		if g == nil {
+			if firstErr == nil {
+				firstErr = err
 			}
+			continue
 		}
 
-		finalFormat := dateFormat + timeFormat + tzFormat
-		return time.Parse(finalFormat, date)
+		valid := err == nil && g.IsValid()
+		if best == nil || (valid && !bestValid) || (valid == bestValid && g.flags.Score < best.flags.Score) {
+			best, bestErr, bestValid = g, err, valid
+		}
+	}
+
+	if best == nil {
+		return nil, firstErr
 	}
 
-	return time.Time{}, errors.New("Not a matching ISO-8601 date")
+	return best, bestErr
 }
 
-func parseToGoment(date, format string, locale locales.LocaleDetails) (*Goment, error) {
-	format = expandLocaleFormats(format, locale)
+// parseToGoment parses the date with the format, using the location for dates without an offset.
+func parseToGoment(date, format string, locale locales.LocaleDetails, strict bool, loc *time.Location) (*Goment, error) {
+	flags := ParsingFlags{
+		Empty:  true,
+		Strict: strict,
+		Format: format,
+	}
 
-	bracketMatch := regexps.BracketRegex.FindAllStringIndex(format, -1)
-	bracketsFound := len(bracketMatch) > 0
+	format = expandLocaleFormats(format, locale)
 
-	// Remove bracketed fields before parsing for tokens.
-	if bracketsFound {
-		for i := range bracketMatch {
+	// Remove bracketed fields before parsing for tokens. When parsing strictly they are kept, since
+	// the bracketed text has to match the input.
+	if !strict {
+		bracketMatch := regexps.BracketRegex.FindAllStringIndex(format, -1)
+		for i := len(bracketMatch) - 1; i >= 0; i-- {
 			format = format[0:bracketMatch[i][0]] + format[bracketMatch[i][1]:len(format)]
 		}
 	}
@@ -379,36 +543,131 @@ func parseToGoment(date, format string, locale locales.LocaleDetails) (*Goment,
 	}
 
 	config := &parseConfig{
-		false,
-		-99999,
-		-1,
-		false,
-		false,
-		false,
-		"",
-		nil,
-		map[int]int{},
-		nil,
-		locale,
-	}
-
-	var found = ""
+		strict:      strict,
+		tzMinutes:   -99999,
+		dayOfYear:   -1,
+		parsedArray: map[int]int{},
+		location:    loc,
+		locale:      locale,
+		hijri:       getGlobalHijriCalendar(),
+		flags:       flags,
+	}
+
+	var strictErr error
 	var remaining = date
 
+	// Literal text in the format since the last matched token. It is not consumed when parsing loosely, but input
+	// matching it is not counted as unused.
+	var literals = ""
+
 	for i := range match {
 		token := match[i][0]
 
-		if rep, ok := parseReplacements[token]; ok {
-			// Find the input value matching the token.
-			found, remaining = rep.findTokenFunction(remaining, locale)
+		rep, ok := parseReplacements[token]
+		if !ok {
+			literal, err := literalFromToken(match[i])
+			if !strict {
+				if err == nil {
+					literals += literal
+				}
+				continue
+			}
+
+			// Literal text in the format must start the remaining input when parsing strictly.
+			if err == nil && strings.HasPrefix(remaining, literal) {
+				remaining = remaining[len(literal):]
+				continue
+			}
+			if err == nil {
+				err = errors.New("Input does not match format text " + literal)
+			}
+			if strictErr == nil {
+				strictErr = err
+			}
+			config.flags.UnusedTokens = append(config.flags.UnusedTokens, token)
+			continue
+		}
+
+		// Find the input value matching the token. When parsing strictly, it must start the remaining input.
+		findToken := rep.findTokenFunction
+		if strict {
+			findToken = rep.strictFindTokenFunction
+		}
+
+		found, rest := findToken(remaining, locale)
+		skipped := remaining[0 : len(remaining)-len(rest)-len(found)]
 
-			if found != "" {
-				rep.configFunction(found, config, locale, token)
+		if found == "" || (strict && skipped != "") {
+			if strict && strictErr == nil {
+				strictErr = errors.New("Input does not match format token " + token)
 			}
+			config.flags.UnusedTokens = append(config.flags.UnusedTokens, token)
+			continue
 		}
+
+		if unused := strings.TrimPrefix(skipped, literals); unused != "" {
+			config.flags.UnusedInput = append(config.flags.UnusedInput, unused)
+		}
+
+		literals = ""
+		remaining = rest
+		config.flags.Empty = false
+		rep.configFunction(found, config, locale, token)
 	}
 
-	return buildFromParseConfig(config)
+	if unused := strings.TrimPrefix(remaining, literals); unused != "" {
+		config.flags.UnusedInput = append(config.flags.UnusedInput, unused)
+		if strict && strictErr == nil {
+			strictErr = errors.New("Input has unparsed text remaining")
+		}
+	}
+
+	config.flags.Meridiem = config.meridiem
+
+	if strictErr != nil {
+		config.flags.Score = parseScore(config.flags)
+		return &Goment{locale: locale, hijri: config.hijri, flags: config.flags}, strictErr
+	}
+
+	g, err := buildFromParseConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	config.flags.Score = parseScore(config.flags)
+	g.hijri = config.hijri
+	g.flags = config.flags
+	if config.strict && config.flags.Overflow != "" {
+		return &Goment{locale: locale, hijri: config.hijri, flags: config.flags}, errors.New("Parsed date has values out of range")
+	}
+
+	return g, nil
+}
+
+// parseScore rates how closely the input matched the format, with lower being better. Each character of unused input
+// counts once, each unused token ten times, and overflowing values add a hundred.
+func parseScore(flags ParsingFlags) int {
+	score := len(strings.Join(flags.UnusedInput, "")) + len(flags.UnusedTokens)*10
+	if flags.Overflow != "" {
+		score += 100
+	}
+	return score
+}
+
+// literalFromToken returns the text a non-parsing token must match, with brackets and escapes removed.
+func literalFromToken(match []string) (string, error) {
+	token := match[0]
+
+	switch {
+	case match[1] != "":
+		return token[1 : len(token)-1], nil
+	case match[2] != "":
+		return token[1:], nil
+	case len([]rune(token)) == 1:
+		return token, nil
+	}
+
+	return "", errors.New("Format token " + token + " is not supported for parsing")
 }
 
 func buildFromParseConfig(config *parseConfig) (*Goment, error) {
@@ -423,6 +682,12 @@ func buildFromParseConfig(config *parseConfig) (*Goment, error) {
 	// Get the current date's values.
 	currentDate := currentDateArray(config)
 
+	if config.calendar != nil {
+		if err := dateFromCalendar(config, currentDate); err != nil {
+			return nil, err
+		}
+	}
+
 	if config.week != nil && !keyExists(dateIdx, config.parsedArray) && !keyExists(monthIdx, config.parsedArray) {
 		dayOfYearFromWeekInfo(config)
 	}
@@ -463,6 +728,8 @@ func buildFromParseConfig(config *parseConfig) (*Goment, error) {
 		}
 	}
 
+	config.flags.Overflow = overflowUnits[checkOverflow(config)]
+
 	createDateFromConfig(config)
 
 	expectedWeekday := config.date.Day()
@@ -473,6 +740,82 @@ func buildFromParseConfig(config *parseConfig) (*Goment, error) {
 	return config.date, nil
 }
 
+// checkOverflow returns the index of the first parsed unit that is out of range, or -1 if all are valid.
+func checkOverflow(config *parseConfig) int {
+	a := config.parsedArray
+
+	overflow := -1
+	switch {
+	case a[monthIdx] < 1 || a[monthIdx] > 12:
+		overflow = monthIdx
+	case a[dateIdx] < 1 || a[dateIdx] > daysInMonth(a[monthIdx], a[yearIdx]):
+		overflow = dateIdx
+	case a[hourIdx] < 0 || a[hourIdx] > 24 || (a[hourIdx] == 24 && (a[minuteIdx] != 0 || a[secondIdx] != 0 || a[nanosecondIdx] != 0)):
+		overflow = hourIdx
+	case a[minuteIdx] < 0 || a[minuteIdx] > 59:
+		overflow = minuteIdx
+	case a[secondIdx] < 0 || a[secondIdx] > 59:
+		overflow = secondIdx
+	case a[nanosecondIdx] < 0 || a[nanosecondIdx] > 999999999:
+		overflow = nanosecondIdx
+	}
+
+	if config.calendar != nil && overflow == -1 {
+		overflow = calendarOverflow(config.calendar, config.calendarDate)
+	}
+
+	if config.overflowDayOfYear && (overflow < yearIdx || overflow > dateIdx) {
+		overflow = dateIdx
+	}
+	if config.overflowWeeks && overflow == -1 {
+		overflow = weekIdx
+	}
+	if config.overflowWeekday && overflow == -1 {
+		overflow = weekdayIdx
+	}
+
+	return overflow
+}
+
+// dateFromCalendar sets the parsed date from the date parsed in the Jalali or Hijri calendar. Missing parts default like
+// the Gregorian calendar.
+func dateFromCalendar(config *parseConfig, currentDate map[int]int) error {
+	year, month, day, _ := config.calendar.date(time.Date(currentDate[yearIdx], time.Month(currentDate[monthIdx]), currentDate[dateIdx], 0, 0, 0, 0, time.UTC))
+	current := map[int]int{yearIdx: year, monthIdx: month, dateIdx: day}
+
+	for i := 0; i < 3 && !keyExists(i, config.calendarDate); i++ {
+		config.calendarDate[i] = current[i]
+	}
+	for i := 1; i < 3; i++ {
+		if !keyExists(i, config.calendarDate) {
+			config.calendarDate[i] = 1
+		}
+	}
+
+	date, ok := config.calendar.time(config.calendarDate[yearIdx], config.calendarDate[monthIdx], config.calendarDate[dateIdx])
+	if !ok {
+		return errors.New(config.calendar.name() + " year " + strconv.Itoa(config.calendarDate[yearIdx]) + " is not supported")
+	}
+
+	config.parsedArray[yearIdx] = date.Year()
+	config.parsedArray[monthIdx] = int(date.Month())
+	config.parsedArray[dateIdx] = date.Day()
+
+	return nil
+}
+
+// calendarOverflow returns the index of the first unit parsed in the calendar that is out of range, or -1 if all are
+// valid.
+func calendarOverflow(calendar calendarSystem, date map[int]int) int {
+	switch {
+	case date[monthIdx] < 1 || date[monthIdx] > 12:
+		return monthIdx
+	case date[dateIdx] < 1 || date[dateIdx] > calendar.daysInMonth(date[yearIdx], date[monthIdx]):
+		return dateIdx
+	}
+	return -1
+}
+
 func dayOfYearFromWeekInfo(config *parseConfig) {
 	var weekday, dow, doy, week, wy int
 	var currWeek weekYear
@@ -585,7 +928,7 @@ func keyExists(key int, m map[int]int) bool {
 }
 
 func createDateFromConfig(config *parseConfig) {
-	loc := time.Local
+	loc := config.location
 	if config.isUTC {
 		loc = time.UTC
 	}
@@ -604,7 +947,7 @@ func createDateFromConfig(config *parseConfig) {
 	d, _ := New(datetime)
 	if config.tzMinutes != -99999 {
 		// Call this method rather than SetMinute, since it checks that minute is between 0-59.
-		d.subtractMinutes(config.tzMinutes)
+		d.addMinutes(config.tzMinutes * -1)
 
 		// Set the offset.
 		d.SetUTCOffset(config.tzMinutes)
@@ -617,6 +960,8 @@ func currentDateArray(config *parseConfig) map[int]int {
 	newDate, _ := New()
 	if config.isUTC {
 		newDate.UTC()
+	} else {
+		newDate.time = newDate.ToTime().In(config.location)
 	}
 	return map[int]int{0: newDate.Year(), 1: newDate.Month(), 2: newDate.Date()}
 }
@@ -659,6 +1004,14 @@ func handleOneToTwentyFourTime(input string, config *parseConfig, locale locales
 	config.parsedArray[hourIdx] = parseNumber(input) - 1
 }
 
+func handleFractionalSecond(input string, config *parseConfig, locale locales.LocaleDetails, token string) {
+	// The digits are a fraction of a second, so pad or truncate them to nanoseconds.
+	if len(input) > 9 {
+		input = input[:9]
+	}
+	config.parsedArray[nanosecondIdx] = parseNumber(input + strings.Repeat("0", 9-len(input)))
+}
+
 func handleMeridiem(input string, config *parseConfig, locale locales.LocaleDetails, token string) {
 	config.meridiem = input
 }
@@ -668,21 +1021,73 @@ func handleOffset(input string, config *parseConfig, locale locales.LocaleDetail
 
 	match := regexps.MatchShortOffset.FindAllString(input, -1)
 	if match != nil {
-		offset := match[0]
-		parts := regexps.ChunkOffset.FindAllString(offset, -1)
+		config.tzMinutes = offsetToMinutes(match[0])
+	}
+}
 
-		minutes := 0
-		if len(parts) == 3 {
-			minutes = (parseNumber(parts[1]) * 60) + parseNumber(parts[2])
-		}
-		if minutes > 0 {
-			if parts[0] == "-" {
-				minutes *= -1
-			}
+// offsetToMinutes converts an offset like +07:00, -0530 or +07 to minutes. Z is treated as 0.
+func offsetToMinutes(offset string) int {
+	parts := regexps.ChunkOffset.FindAllString(offset, -1)
+	if len(parts) < 2 {
+		return 0
+	}
+
+	minutes := parseNumber(parts[1]) * 60
+	if len(parts) > 2 {
+		minutes += parseNumber(parts[2])
+	}
+	if parts[0] == "-" {
+		minutes *= -1
+	}
+
+	return minutes
+}
+
+func handleJalali(input string, config *parseConfig, locale locales.LocaleDetails, token string) {
+	switch token {
+	case "jMMM", "jMMMM":
+		setCalendarParsedUnit(config, jalali, monthIdx, locale.GetJalaliMonthNumber(input))
+	default:
+		setCalendarParsedNumber(config, jalali, token[1:], input)
+	}
+}
+
+func handleHijri(input string, config *parseConfig, locale locales.LocaleDetails, token string) {
+	switch token {
+	case "iMMM", "iMMMM":
+		setCalendarParsedUnit(config, config.hijri, monthIdx, locale.GetHijriMonthNumber(input))
+	default:
+		setCalendarParsedNumber(config, config.hijri, token[1:], input)
+	}
+}
+
+// setCalendarParsedNumber sets a number parsed in the calendar with a date token like YY, M or D.
+func setCalendarParsedNumber(config *parseConfig, calendar calendarSystem, token, input string) {
+	number := parseNumber(input)
+	switch token {
+	case "YY":
+		// Two digit Jalali & Hijri years are between 1348 & 1447.
+		if number > 47 {
+			number += 1300
+		} else {
+			number += 1400
 		}
+		setCalendarParsedUnit(config, calendar, yearIdx, number)
+	case "YYYY":
+		setCalendarParsedUnit(config, calendar, yearIdx, number)
+	case "M", "MM":
+		setCalendarParsedUnit(config, calendar, monthIdx, number)
+	case "D", "DD":
+		setCalendarParsedUnit(config, calendar, dateIdx, number)
+	}
+}
 
-		config.tzMinutes = minutes
+func setCalendarParsedUnit(config *parseConfig, calendar calendarSystem, idx, value int) {
+	if config.calendarDate == nil {
+		config.calendarDate = map[int]int{}
 	}
+	config.calendar = calendar
+	config.calendarDate[idx] = value
 }
 
 func handleLongMonth(input string, config *parseConfig, locale locales.LocaleDetails, token string) {
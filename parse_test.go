@@ -28,18 +28,103 @@ func TestISOParsing(t *testing.T) {
 		testParseable{"2013-02-08 09+0700", time.Date(2013, 2, 8, 9, 0, 0, 0, getLocation("Antarctica/Davis"))},
 		testParseable{"2013-02-08T09:30:26.123Z", time.Date(2013, 2, 8, 9, 30, 26, 123, time.UTC)},
 		testParseable{"2013-02-08T09:30:26Z", time.Date(2013, 2, 8, 9, 30, 26, 0, time.UTC)},
-		// testParseable{"2013-02-08 09+07:00", time.Date(2013, 2, 8, 9, 0, 0, 0, getLocation("America/Chicago"))}, // Need to support : in tz
-		// testParseable{"2013-02-08 09:30:26,123", time.Date(2013, 2, 8, 9, 30, 26, 123*1000*1000, chicagoLocation())}, comma in date string not supported by Go
-		// testParseable{"+002010-01-01", time.Date(2010, 1, 1, 0, 0, 0, 0, chicagoLocation())},
-		// testParseable{"-002010-01-01", time.Date(2010, 1, 1, 0, 0, 0, 0, chicagoLocation())},
+		testParseable{"2013-02-08 09+07:00", time.Date(2013, 2, 8, 9, 0, 0, 0, getLocation("Antarctica/Davis"))},
+		testParseable{"2013-02-08 09:30:26,123", time.Date(2013, 2, 8, 9, 30, 26, calculateNanoseconds(123), time.UTC)},
+		testParseable{"20130208T093026,123", time.Date(2013, 2, 8, 9, 30, 26, calculateNanoseconds(123), time.UTC)},
+		testParseable{"+002010-01-01", time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC)},
+		testParseable{"-002010-01-01", time.Date(-2010, 1, 1, 0, 0, 0, 0, time.UTC)},
+		testParseable{"+0020100101", time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC)},
+		testParseable{"+012010-02", time.Date(12010, 2, 1, 0, 0, 0, 0, time.UTC)},
+		testParseable{"2024-W05-3", time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)},
+		testParseable{"2024-W05", time.Date(2024, 1, 29, 0, 0, 0, 0, time.UTC)},
+		testParseable{"2020-W53-7", time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)},
+		testParseable{"2019-W01-1", time.Date(2018, 12, 31, 0, 0, 0, 0, time.UTC)},
+		testParseable{"2024W053", time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)},
+		testParseable{"2024W05", time.Date(2024, 1, 29, 0, 0, 0, 0, time.UTC)},
+		testParseable{"+002024-W05-3", time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)},
+		testParseable{"+002024W053", time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)},
+		testParseable{"2024-W05-3T10:30", time.Date(2024, 1, 31, 10, 30, 0, 0, time.UTC)},
+		testParseable{"2024-060", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		testParseable{"2024060", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		testParseable{"+002024-060", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		testParseable{"+002024060", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		testParseable{"2024-060T23:59:59.5Z", time.Date(2024, 2, 29, 23, 59, 59, calculateNanoseconds(500), time.UTC)},
+		testParseable{"2024-01-01T24:00", time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)},
+		testParseable{"2024-12-31T24:00:00Z", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		testParseable{"20240101T24", time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)},
+		testParseable{"2024-01-01T10.5", time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC)},
+		testParseable{"2024-01-01T10,25Z", time.Date(2024, 1, 1, 10, 15, 0, 0, time.UTC)},
+		testParseable{"2024-01-01T10:30.5", time.Date(2024, 1, 1, 10, 30, 30, 0, time.UTC)},
+		testParseable{"20240101T1030,5", time.Date(2024, 1, 1, 10, 30, 30, 0, time.UTC)},
+		testParseable{"2024-01-01T23:59.99-0600", time.Date(2024, 1, 1, 23, 59, 59, calculateNanoseconds(400), chicagoLocation())},
 	}
 
 	for _, p := range parseable {
-		parsed, _ := parseISOString(p.dateTime)
+		parsed, _ := parseISOString(p.dateTime, time.UTC)
 		assert.Equal(t, simpleTime(p.parsedTime).Format(), simpleTime(parsed).Format(), fmt.Sprintf("%s not equal to %s", parsed, p.parsedTime))
 	}
 }
 
+func TestISOParsingErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	invalid := []string{
+		"2023-02-29",
+		"2023-366",
+		"2024-W54-1",
+		"2024-W05-8",
+		"2024-W05T10",
+		"2024-05T10:00",
+		"202405",
+		"2024-01-01T25:00",
+		"2024-01-01T10:60",
+		"2024-01-01T24:30",
+		"2024-01-01T24:00:01",
+		"2024-01-01T24.5",
+	}
+
+	for _, date := range invalid {
+		_, err := parseISOString(date, time.UTC)
+		assert.Error(err, date)
+	}
+}
+
+func TestRFC2822Parsing(t *testing.T) {
+	assert := assert.New(t)
+
+	parseable := []testParseable{
+		testParseable{"Tue, 01 Nov 2016 01:23:45 +0000", time.Date(2016, 11, 1, 1, 23, 45, 0, time.UTC)},
+		testParseable{"Mon, 02 Jan 2006 15:04:05 GMT", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)},
+		testParseable{"Mon, 02 Jan 2006 15:04:05 UT", time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)},
+		testParseable{"Tue, 1 Nov 2016 01:23:45 +0530", time.Date(2016, 11, 1, 1, 23, 45, 0, time.FixedZone("", 330*60))},
+		testParseable{"Tue, 01 Nov 2016 01:23 -0600", time.Date(2016, 11, 1, 1, 23, 0, 0, time.FixedZone("", -360*60))},
+		testParseable{"01 Nov 2016 01:23:45 EST", time.Date(2016, 11, 1, 1, 23, 45, 0, time.FixedZone("", -300*60))},
+		testParseable{"Tue,  01 Nov 2016 01:23:45 PDT", time.Date(2016, 11, 1, 1, 23, 45, 0, time.FixedZone("", -420*60))},
+		testParseable{"Tue, 01 Nov 2016 01:23:45 CDT (Central Daylight Time)", time.Date(2016, 11, 1, 1, 23, 45, 0, time.FixedZone("", -300*60))},
+		testParseable{"Tue, 01 Nov 16 01:23:45 Z", time.Date(2016, 11, 1, 1, 23, 45, 0, time.UTC)},
+		testParseable{"Tue, 01 Nov 116 01:23:45 A", time.Date(2016, 11, 1, 1, 23, 45, 0, time.UTC)},
+		testParseable{"Mon, 01 Nov 99 01:23:45 +0000", time.Date(1999, 11, 1, 1, 23, 45, 0, time.UTC)},
+	}
+
+	for _, p := range parseable {
+		lib, err := New(p.dateTime)
+		assert.NoError(err, p.dateTime)
+		assert.True(lib.ToTime().Equal(p.parsedTime), p.dateTime)
+		_, offset := lib.ToTime().Zone()
+		_, expectedOffset := p.parsedTime.Zone()
+		assert.Equal(expectedOffset, offset, p.dateTime)
+	}
+
+	_, err := New("Wed, 01 Nov 2016 01:23:45 +0000")
+	assert.EqualError(err, "There is a mismatch between parsed weekday and expected weekday")
+
+	_, err = New("Tue, 31 Nov 2016 01:23:45 +0000")
+	assert.EqualError(err, "RFC 2822 date has values out of range")
+
+	_, err = New("Tue, 01 Nov 2016 01:23:45 XYZ")
+	assert.EqualError(err, "Not a matching ISO-8601 date")
+}
+
 func TestFormatParsing(t *testing.T) {
 	assert := assert.New(t)
 
@@ -80,31 +165,26 @@ func TestFormatParsing(t *testing.T) {
 		"YYYY-MM-DD HH:mm Z":        []string{"2010-10-20 04:30 +00:00"},
 		"e":                         []string{"0", "5"},
 		"E":                         []string{"1", "7"},
-		// "HH:mm:ss.S": []string{"00:30:00.1"},
-		// "HH:mm:ss SS":               "00:30:00 12",
-		// "HH:mm:ss SSS":              "00:30:00 123",
-		// "HH:mm:ss S":                "00:30:00 7",
-		// "HH:mm:ss SS":               "00:30:00 78",
-		// "HH:mm:ss SSS":              "00:30:00 789",
-		// "kk:mm:ss S":   "24:30:00 1",
-		// "kk:mm:ss SS":  "24:30:00 12",
-		// "kk:mm:ss SSS": "24:30:00 123",
-		// "kk:mm:ss S":   "24:30:00 7",
-		// "kk:mm:ss SS":  "24:30:00 78",
-		// "kk:mm:ss SSS": "24:30:00 789",
-		"X":    []string{"1234567890"},
-		"H Z":  []string{"6 -06:00"},
-		"H ZZ": []string{"5 -0700"},
-		"LT":   []string{"12:30 AM"},
-		"LTS":  []string{"12:30:29 AM"},
-		"L":    []string{"09/02/1999"},
-		"l":    []string{"9/2/1999"},
-		"LL":   []string{"September 2, 1999"},
-		"ll":   []string{"Sep 2, 1999"},
-		"LLL":  []string{"September 2, 1999 12:30 AM"},
-		"lll":  []string{"Sep 2, 1999 12:30 AM"},
-		"LLLL": []string{"Thursday, September 2, 1999 12:30 AM"},
-		"llll": []string{"Thu, Sep 2, 1999 12:30 AM"},
+		"HH:mm:ss.S":                []string{"00:30:00.1"},
+		"HH:mm:ss SS":               []string{"00:30:00 12", "00:30:00 78"},
+		"HH:mm:ss SSS":              []string{"00:30:00 123", "00:30:00 789"},
+		"HH:mm:ss S":                []string{"00:30:00 7"},
+		"HH:mm:ss.SSSSSS":           []string{"00:30:00.123456"},
+		"HH:mm:ss.SSSSSSSSS":        []string{"00:30:00.123456789"},
+		"YYYY-MM-DD HH:mm:ss.SSSSS": []string{"2024-03-05 10:30:00.00001"},
+		"X":                         []string{"1234567890"},
+		"H Z":                       []string{"6 -06:00"},
+		"H ZZ":                      []string{"5 -0700"},
+		"LT":                        []string{"12:30 AM"},
+		"LTS":                       []string{"12:30:29 AM"},
+		"L":                         []string{"09/02/1999"},
+		"l":                         []string{"9/2/1999"},
+		"LL":                        []string{"September 2, 1999"},
+		"ll":                        []string{"Sep 2, 1999"},
+		"LLL":                       []string{"September 2, 1999 12:30 AM"},
+		"lll":                       []string{"Sep 2, 1999 12:30 AM"},
+		"LLLL":                      []string{"Thursday, September 2, 1999 12:30 AM"},
+		"llll":                      []string{"Thu, Sep 2, 1999 12:30 AM"},
 	}
 
 	for format, dates := range formats {
@@ -129,6 +209,25 @@ func TestFormatNoSeparators(t *testing.T) {
 	}
 }
 
+func TestFractionalSecondParsing(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(100000000, simpleFormat("10:30:00.1", "HH:mm:ss.S").Nanosecond())
+	assert.Equal(120000000, simpleFormat("10:30:00.12", "HH:mm:ss.SS").Nanosecond())
+	assert.Equal(123000000, simpleFormat("10:30:00.123", "HH:mm:ss.SSS").Nanosecond())
+	assert.Equal(123456000, simpleFormat("10:30:00.123456", "HH:mm:ss.SSSSSS").Nanosecond())
+	assert.Equal(123456789, simpleFormat("10:30:00.123456789", "HH:mm:ss.SSSSSSSSS").Nanosecond())
+	assert.Equal(123456789, simpleFormat("10:30:00.1234567891", "HH:mm:ss.SSSSSSSSS").Nanosecond(), "digits past nanoseconds are truncated")
+	assert.Equal(123000000, simpleFormat("10:30:00.123", "HH:mm:ss.S").Nanosecond(), "non-strict reads up to three digits")
+
+	_, err := NewStrict("10:30:00.123", "HH:mm:ss.S")
+	assert.Error(err, "strict requires exact digits")
+
+	lib, err := NewStrict("10:30:00.000123", "HH:mm:ss.SSSSSS")
+	assert.NoError(err)
+	assert.Equal(123000, lib.Nanosecond())
+}
+
 func TestHourFormatParsing(t *testing.T) {
 	lib := simpleFormat("23", "h")
 	assert.Equal(t, "11", lib.Format("h"), "h: 23")
@@ -278,6 +377,135 @@ func TestISO8601Timestamp(t *testing.T) {
 	assert.Equal("2020-09-01T20:46:07+00:00", simpleFormat("2020-09-01T20:46:07Z", "YYYY-MM-DDTHH:mm:ssZ").Format(outputFormat))
 }
 
+func TestStrictParsing(t *testing.T) {
+	assert := assert.New(t)
+
+	formats := map[string][]string{
+		"YYYY-MM-DD":              []string{"2024-02-29", "1999-12-31"},
+		"MM/DD/YYYY h:mm a":       []string{"03/05/2024 4:30 pm"},
+		"MMMM Do, YYYY":           []string{"March 5th, 2024"},
+		"YYYY-MM-DD[T]HH:mm:ss":   []string{"2024-03-05T23:59:59"},
+		"GGGG-[W]WW-E":            []string{"2024-W05-3"},
+		"dddd, MMMM D YYYY HH:mm": []string{"Tuesday, March 5 2024 08:15"},
+	}
+
+	for format, dates := range formats {
+		for _, date := range dates {
+			lib, err := NewStrict(date, format)
+			assert.NoError(err, fmt.Sprintf("%v: %v", format, date))
+			assert.Equal(date, lib.Format(format), fmt.Sprintf("%v: %v", format, date))
+		}
+	}
+
+	lib, err := NewStrict("5 марта 2024", "D MMMM YYYY", "en")
+	assert.Error(err, "locale month names must match")
+	assert.False(lib.IsValid())
+
+	lib, err = NewStrict("2 de septiembre de 1999 12:30", "LLL", "es")
+	assert.NoError(err, "locale formats are expanded")
+	assert.Equal("1999-09-02 12:30", lib.Format("YYYY-MM-DD HH:mm"))
+}
+
+func TestStrictParsingRejectsInvalidInput(t *testing.T) {
+	assert := assert.New(t)
+
+	invalid := map[string][]string{
+		"YYYY-MM-DD":    []string{"2024-13-45", "2023-02-29", "2024-1-05", "24-01-05", "2024-01-05 ", " 2024-01-05", "2024/01/05"},
+		"MMMM Do":       []string{"March 5th garbage", "Marc 5th"},
+		"HH:mm:ss":      []string{"24:01:00", "12:60:00", "12:00:61", "1:00:00"},
+		"YYYY-MM-DD[T]": []string{"2024-01-05X"},
+		"YYYY-DDDD":     []string{"2023-366"},
+		"GGGG-[W]WW":    []string{"2024-W54"},
+		"YYYY Mo":       []string{"2024 1st"},
+	}
+
+	for format, dates := range invalid {
+		for _, date := range dates {
+			_, err := NewStrict(date, format)
+			assert.Error(err, fmt.Sprintf("%v: %v", format, date))
+		}
+	}
+
+	_, err := NewStrict("March 5th garbage", "MMMM Do")
+	assert.EqualError(err, "Input has unparsed text remaining")
+
+	_, err = NewStrict("2024-13-45", "YYYY-MM-DD")
+	assert.EqualError(err, "Parsed date has values out of range")
+
+	_, err = NewStrict("2024/01/05", "YYYY-MM-DD")
+	assert.EqualError(err, "Input does not match format text -")
+
+	_, err = NewStrict("2024-1-05", "YYYY-MM-DD")
+	assert.EqualError(err, "Input does not match format token MM")
+
+	// Non-strict parsing is unchanged.
+	_, err = New("March 5th garbage", "MMMM Do")
+	assert.NoError(err)
+}
+
+func TestStrictParsingArguments(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := NewStrict("2024-01-05")
+	assert.EqualError(err, "Invalid number of arguments")
+
+	_, err = NewStrict(2024, "YYYY")
+	assert.EqualError(err, "First argument must be a datetime string")
+
+	_, err = NewStrict("2024", 1)
+	assert.EqualError(err, "Second argument must be a format string")
+
+	_, err = NewStrict("2024", "YYYY", "xx")
+	assert.EqualError(err, "Invalid locale code")
+}
+
+func TestMultipleFormatParsing(t *testing.T) {
+	assert := assert.New(t)
+
+	formats := []string{"DD/MM/YYYY", "YYYY-MM-DD", "MMM D, YYYY"}
+
+	dates := map[string]string{
+		"05/03/2024":  "DD/MM/YYYY",
+		"2024-03-05":  "YYYY-MM-DD",
+		"Mar 5, 2024": "MMM D, YYYY",
+	}
+
+	for date, format := range dates {
+		lib, err := New(date, formats)
+		assert.NoError(err, date)
+		assert.True(lib.IsValid(), date)
+		assert.Equal("2024-03-05", lib.Format("YYYY-MM-DD"), date)
+		assert.Equal(format, lib.ParsingFlags().Format, date)
+		assert.Equal(0, lib.ParsingFlags().Score, date)
+	}
+
+	lib, err := New("2024-13-05", []string{"YYYY-MM-DD", "YYYY-DD-MM"})
+	assert.NoError(err)
+	assert.True(lib.IsValid(), "valid results are preferred")
+	assert.Equal("YYYY-DD-MM", lib.ParsingFlags().Format)
+	assert.Equal("2024-05-13", lib.Format("YYYY-MM-DD"))
+
+	lib, err = New("2024-03-05 10:30", []string{"YYYY-MM-DD", "YYYY-MM-DD HH:mm"})
+	assert.NoError(err)
+	assert.Equal("YYYY-MM-DD HH:mm", lib.ParsingFlags().Format, "fewer unused characters is preferred")
+
+	lib, err = New("5 mars 2024", []string{"YYYY-MM-DD", "D MMMM YYYY"}, "fr")
+	assert.NoError(err)
+	assert.Equal("D MMMM YYYY", lib.ParsingFlags().Format)
+	assert.Equal("fr", lib.Locale())
+
+	lib, err = NewStrict("2024-03-05", formats)
+	assert.NoError(err)
+	assert.Equal("YYYY-MM-DD", lib.ParsingFlags().Format)
+
+	lib, err = NewStrict("2024-03-05 garbage", formats)
+	assert.EqualError(err, "Input has unparsed text remaining")
+	assert.False(lib.IsValid())
+
+	_, err = New("2024-03-05", []string{})
+	assert.EqualError(err, "No formats supplied")
+}
+
 func getLocation(locationName string) *time.Location {
 	location, _ := time.LoadLocation(locationName)
 	return location
@@ -0,0 +1,155 @@
+package goment
+
+import (
+	"time"
+
+	"github.com/nleeper/goment/locales"
+)
+
+// PreciseDifference is the calendar difference between two Goments, split into parts. The parts are never negative,
+// and Sign gives the direction of the difference.
+type PreciseDifference struct {
+	Years       int
+	Months      int
+	Days        int
+	Hours       int
+	Minutes     int
+	Seconds     int
+	Nanoseconds int
+	// Sign is 1 if the Goment is after the date it was compared to, -1 if it is before it, and 0 if they are the same.
+	Sign   int
+	locale locales.LocaleDetails
+}
+
+// PreciseDiff returns the calendar difference from a date to the Goment, which accepts the same arguments as New.
+// The date is compared in the time zone of the Goment, and adding the Duration of the difference to the date in that
+// time zone gives the Goment. Nil is returned if the date is not valid.
+func (g *Goment) PreciseDiff(date interface{}) *PreciseDifference {
+	other, err := New(date)
+	if err != nil {
+		return nil
+	}
+
+	end := g.ToTime()
+	start := other.ToTime().In(end.Location())
+	p := &PreciseDifference{locale: g.LocaleDetails()}
+
+	switch {
+	case end.After(start):
+		p.Sign = 1
+	case end.Before(start):
+		p.Sign = -1
+	default:
+		return p
+	}
+
+	passes := func(t time.Time) bool {
+		if p.Sign > 0 {
+			return t.After(end)
+		}
+		return t.Before(end)
+	}
+
+	// The months & days are added to the date the same way Add does, so the difference can be added back. Counting
+	// down from the difference in the calendar fields keeps the months & days as small as possible.
+	months := abs((end.Year()-start.Year())*12 + int(end.Month()-start.Month()))
+	for months > 0 && passes(start.AddDate(0, p.Sign*months, 0)) {
+		months--
+	}
+	mid := start.AddDate(0, p.Sign*months, 0)
+
+	days := abs(civilDay(end) - civilDay(mid))
+	for days > 0 && passes(mid.AddDate(0, 0, p.Sign*days)) {
+		days--
+	}
+	mid = mid.AddDate(0, 0, p.Sign*days)
+
+	rest := end.Sub(mid)
+	if rest < 0 {
+		rest = -rest
+	}
+
+	p.Years = months / 12
+	p.Months = months % 12
+	p.Days = days
+	p.Hours = int(rest / time.Hour)
+	p.Minutes = int(rest % time.Hour / time.Minute)
+	p.Seconds = int(rest % time.Minute / time.Second)
+	p.Nanoseconds = int(rest % time.Second)
+
+	return p
+}
+
+// Duration returns the difference as a Duration, which is negative if the Sign is.
+func (p *PreciseDifference) Duration() *Duration {
+	d, _ := NewDuration(DurationParts{
+		Years:       p.Sign * p.Years,
+		Months:      p.Sign * p.Months,
+		Days:        p.Sign * p.Days,
+		Hours:       p.Sign * p.Hours,
+		Minutes:     p.Sign * p.Minutes,
+		Seconds:     p.Sign * p.Seconds,
+		Nanoseconds: p.Sign * p.Nanoseconds,
+	})
+	d.locale = p.LocaleDetails()
+	return d
+}
+
+// String returns the difference in the locale, like "2 years, 3 months and 4 days". Parts that are zero and the
+// nanoseconds are left out.
+func (p *PreciseDifference) String() string {
+	locale := p.LocaleDetails()
+
+	parts := []string{}
+	for _, part := range []struct {
+		number   int
+		singular string
+		plural   string
+	}{
+		{p.Years, "y", "yy"},
+		{p.Months, "M", "MM"},
+		{p.Days, "d", "dd"},
+		{p.Hours, "h", "hh"},
+		{p.Minutes, "m", "mm"},
+		// The locale's single second is a few seconds, so seconds are always a number.
+		{p.Seconds, "ss", "ss"},
+	} {
+		switch part.number {
+		case 0:
+		case 1:
+			parts = append(parts, locale.RelativeTime(part.singular, part.number, true, false))
+		default:
+			parts = append(parts, locale.RelativeTime(part.plural, part.number, true, false))
+		}
+	}
+
+	if len(parts) == 0 {
+		return locale.RelativeTime("ss", 0, true, false)
+	}
+	return locale.List(parts)
+}
+
+// Locale returns the locale code of the difference.
+func (p *PreciseDifference) Locale() string {
+	return p.LocaleDetails().Code
+}
+
+// LocaleDetails returns the locale details of the difference.
+func (p *PreciseDifference) LocaleDetails() locales.LocaleDetails {
+	if p.locale.Code == "" {
+		return getGlobalLocaleDetails()
+	}
+	return p.locale
+}
+
+// SetLocale sets the locale of the difference, used by String.
+func (p *PreciseDifference) SetLocale(localeCode string) error {
+	locale, err := loadLocale(localeCode)
+	if err != nil {
+		return err
+	}
+
+	p.locale = locale
+
+	return nil
+}
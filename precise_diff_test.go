@@ -0,0 +1,93 @@
+package goment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreciseDiff(t *testing.T) {
+	assert := assert.New(t)
+
+	p := simpleString("2024-05-05T13:30:15.5Z").PreciseDiff("2022-02-01T12:00:00Z")
+	assert.Equal(1, p.Sign)
+	assert.Equal([]int{2, 3, 4, 1, 30, 15, 500000000}, []int{p.Years, p.Months, p.Days, p.Hours, p.Minutes, p.Seconds, p.Nanoseconds})
+
+	p = simpleString("2022-02-01T12:00:00Z").PreciseDiff("2024-05-05T13:30:15.5Z")
+	assert.Equal(-1, p.Sign)
+	assert.Equal([]int{2, 3, 4, 1, 30, 15, 500000000}, []int{p.Years, p.Months, p.Days, p.Hours, p.Minutes, p.Seconds, p.Nanoseconds})
+
+	p = simpleString("2024-01-01").PreciseDiff("2024-01-01")
+	assert.Equal(PreciseDifference{}, PreciseDifference{Years: p.Years, Months: p.Months, Days: p.Days, Hours: p.Hours, Minutes: p.Minutes, Seconds: p.Seconds, Nanoseconds: p.Nanoseconds, Sign: p.Sign})
+
+	assert.Nil(simpleString("2024-01-01").PreciseDiff("not a date"))
+}
+
+func TestPreciseDiffMonthEnds(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		from     string
+		to       string
+		expected []int
+	}{
+		// A month after the 31st of January is in March, so the end of February is days away.
+		{"2024-01-31", "2024-02-29", []int{0, 0, 29}},
+		{"2024-01-31", "2024-03-31", []int{0, 2, 0}},
+		{"2024-02-29", "2025-02-28", []int{0, 11, 30}},
+		{"2024-02-29", "2028-02-29", []int{4, 0, 0}},
+		{"2023-12-15", "2024-01-14", []int{0, 0, 30}},
+		{"2024-03-31", "2024-03-01", []int{0, 0, 30}},
+		// A month before the 31st of March is the 2nd of March.
+		{"2024-03-31", "2024-02-29", []int{0, 1, 2}},
+	}
+
+	for _, test := range tests {
+		p := simpleString(test.to).PreciseDiff(test.from)
+		assert.Equal(test.expected, []int{p.Years, p.Months, p.Days}, test.from+" to "+test.to)
+		assert.Equal(test.to, simpleString(test.from).Add(p.Duration()).Format("YYYY-MM-DD"), test.from+" to "+test.to)
+	}
+}
+
+func TestPreciseDiffAddsBack(t *testing.T) {
+	assert := assert.New(t)
+
+	chicago := chicagoLocation()
+	start := time.Date(2023, 12, 28, 22, 45, 10, 250, chicago)
+
+	for i := 0; i < 800; i++ {
+		from := start.AddDate(0, 0, i*3).Add(time.Duration(i*i) * time.Minute)
+		for _, to := range []time.Time{
+			time.Date(2024, 2, 29, 0, 0, 0, 0, chicago),
+			time.Date(2024, 3, 10, 3, 30, 0, 0, chicago),
+			time.Date(2024, 11, 3, 1, 30, 0, 0, chicago).Add(time.Hour),
+			time.Date(2025, 1, 31, 23, 59, 59, 999999999, time.UTC),
+		} {
+			p := simpleTime(to).PreciseDiff(from)
+			sum := simpleTime(from.In(to.Location())).Add(p.Duration())
+			assert.True(sum.ToTime().Equal(to), "%v to %v gave %v", from, to, sum.ToTime())
+			assert.True(p.Months < 12 && p.Hours < 25 && p.Minutes < 60 && p.Seconds < 60)
+		}
+	}
+}
+
+func TestPreciseDiffString(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("2 years, 3 months and 4 days", simpleString("2024-05-05").PreciseDiff("2022-02-01").String())
+	assert.Equal("a year and a day", simpleString("2024-01-02").PreciseDiff("2023-01-01").String())
+	assert.Equal("3 hours, 2 minutes and 1 second", simpleString("2024-01-01T03:02:01Z").PreciseDiff("2024-01-01").String())
+	assert.Equal("5 days", simpleString("2024-01-01").PreciseDiff("2024-01-06").String())
+	assert.Equal("0 seconds", simpleString("2024-01-01").PreciseDiff("2024-01-01").String())
+
+	g := simpleString("2024-05-05")
+	g.SetLocale("es")
+	assert.Equal("2 años, 3 meses y 4 días", g.PreciseDiff("2022-02-01").String())
+
+	p := simpleString("2024-05-05").PreciseDiff("2022-02-01")
+	assert.Nil(p.SetLocale("fr"))
+	assert.Equal("fr", p.Locale())
+	assert.Equal("2 ans, 3 mois et 4 jours", p.String())
+	assert.EqualError(p.SetLocale("xx"), "Locale xx is not supported")
+}
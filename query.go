@@ -83,7 +83,7 @@ func weeksInYear(year int, dow int, doy int) int {
 func firstWeekOffset(year int, dow int, doy int) int {
 	fwd := 7 + dow - doy
 
-	d, _ := New(DateTime{Year: year, Month: 1, Day: fwd})
+	d, _ := New(DateTime{Year: year, Month: 1, Day: fwd, Location: time.UTC})
 	fwdlw := (7 + d.UTC().Day() - dow) % 7
 
 	return -fwdlw + fwd - 1
@@ -0,0 +1,345 @@
+package goment
+
+import (
+	"errors"
+	"time"
+)
+
+// Range is a range of time between a start & end Goment. The inclusivity sets whether the start & end are part of the
+// range, using the same notation as IsBetween: "[]" includes both, "()" excludes both, "[)" and "(]" include one.
+type Range struct {
+	start          *Goment
+	end            *Goment
+	startInclusive bool
+	endInclusive   bool
+}
+
+// NewRange creates a Range from a start & end, which accept the same arguments as New, and an optional inclusivity.
+// The range includes both the start & end by default. An empty Range is returned with the error.
+func NewRange(start, end interface{}, inclusivity ...string) (*Range, error) {
+	s, err := New(start)
+	if err != nil {
+		return &Range{}, err
+	}
+
+	e, err := New(end)
+	if err != nil {
+		return &Range{}, err
+	}
+
+	if s.ToTime().After(e.ToTime()) {
+		return &Range{}, errors.New("Range start must not be after the end")
+	}
+
+	r := &Range{start: s, end: e, startInclusive: true, endInclusive: true}
+
+	if len(inclusivity) > 0 {
+		if !inclusivityRegex.MatchString(inclusivity[0]) {
+			return &Range{}, errors.New("Inclusivity must be one of (), [), (] or []")
+		}
+		r.startInclusive = inclusivity[0][0] == '['
+		r.endInclusive = inclusivity[0][1] == ']'
+	}
+
+	return r, nil
+}
+
+// Start returns a copy of the start of the Range.
+func (r *Range) Start() *Goment {
+	return r.from().Clone()
+}
+
+// End returns a copy of the end of the Range.
+func (r *Range) End() *Goment {
+	return r.to().Clone()
+}
+
+// Inclusivity returns whether the start & end are part of the Range, like "[)".
+func (r *Range) Inclusivity() string {
+	inclusivity := "("
+	if r.startInclusive {
+		inclusivity = "["
+	}
+	if r.endInclusive {
+		return inclusivity + "]"
+	}
+	return inclusivity + ")"
+}
+
+// IsEmpty checks if the Range contains no time, which is the case when the start & end are the same and either is
+// excluded.
+func (r *Range) IsEmpty() bool {
+	return isEmptyRange(r.from().ToTime(), r.to().ToTime(), r.startInclusive, r.endInclusive)
+}
+
+// Duration returns the length of time between the start & end of the Range.
+func (r *Range) Duration() *Duration {
+	d, _ := NewDuration(r.to().ToTime().Sub(r.from().ToTime()))
+	return d
+}
+
+// Contains checks if a date is within the Range. The date accepts the same arguments as New. If a Range is passed,
+// it checks that the whole of that Range is within the Range.
+func (r *Range) Contains(date interface{}) bool {
+	switch v := date.(type) {
+	case *Range:
+		return r.containsRange(v)
+	case Range:
+		return r.containsRange(&v)
+	}
+
+	g, err := New(date)
+	if err != nil {
+		return false
+	}
+
+	t := g.ToTime()
+	return r.afterStart(t) && r.beforeEnd(t)
+}
+
+// Overlaps checks if the Range shares any time with another Range.
+func (r *Range) Overlaps(other *Range) bool {
+	return r.Intersect(other) != nil
+}
+
+// Intersect returns the Range of time shared by the Range & another Range, or nil if they do not overlap.
+func (r *Range) Intersect(other *Range) *Range {
+	start, startInclusive := r.from(), r.startInclusive
+	if compareStarts(other.from(), other.startInclusive, start, startInclusive) > 0 {
+		start, startInclusive = other.from(), other.startInclusive
+	}
+
+	end, endInclusive := r.to(), r.endInclusive
+	if compareEnds(other.to(), other.endInclusive, end, endInclusive) < 0 {
+		end, endInclusive = other.to(), other.endInclusive
+	}
+
+	return newRangeOrNil(start, end, startInclusive, endInclusive)
+}
+
+// Union returns the Range covering both the Range & another Range, or nil if they neither overlap nor touch.
+func (r *Range) Union(other *Range) *Range {
+	if !r.Overlaps(other) && !r.isAdjacent(other) && !other.isAdjacent(r) {
+		return nil
+	}
+
+	start, startInclusive := r.from(), r.startInclusive
+	if compareStarts(other.from(), other.startInclusive, start, startInclusive) < 0 {
+		start, startInclusive = other.from(), other.startInclusive
+	}
+
+	end, endInclusive := r.to(), r.endInclusive
+	if compareEnds(other.to(), other.endInclusive, end, endInclusive) > 0 {
+		end, endInclusive = other.to(), other.endInclusive
+	}
+
+	return newRangeOrNil(start, end, startInclusive, endInclusive)
+}
+
+// Subtract returns the parts of the Range that are not in another Range. There can be none, one or two parts.
+func (r *Range) Subtract(other *Range) []*Range {
+	if !r.Overlaps(other) {
+		if r.IsEmpty() {
+			return []*Range{}
+		}
+		return []*Range{r.clone()}
+	}
+
+	ranges := []*Range{}
+
+	if before := newRangeOrNil(r.from(), other.from(), r.startInclusive, !other.startInclusive); before != nil {
+		ranges = append(ranges, before)
+	}
+
+	if after := newRangeOrNil(other.to(), r.to(), !other.endInclusive, r.endInclusive); after != nil {
+		ranges = append(ranges, after)
+	}
+
+	return ranges
+}
+
+// By returns the Goments from the start of the Range to its end, in steps of the amount of the units. The units can
+// be a Unit or a unit string. Steps of months, quarters & years pin the date to the end of a shorter month. Nil is
+// returned if the units are not supported or the step is not positive.
+func (r *Range) By(units interface{}, step int) []*Goment {
+	unit := unitFromArg(units)
+	if !unit.IsValid() || step <= 0 {
+		return nil
+	}
+
+	goments := []*Goment{}
+
+	for i := 0; ; i++ {
+		// Each Goment is stepped from the start, so that a date pinned to a shorter month doesn't stay pinned.
+		current := addUnitPinned(r.from().Clone(), i*step, unit)
+		t := current.ToTime()
+
+		if !r.beforeEnd(t) {
+			break
+		}
+		if r.afterStart(t) {
+			goments = append(goments, current)
+		}
+	}
+
+	return goments
+}
+
+// addUnitPinned adds the amount of the units to a Goment. Months, quarters & years, including Jalali & Hijri ones, pin
+// the date to the end of a shorter month rather than overflowing into the next one.
+func addUnitPinned(g *Goment, amount int, unit Unit) *Goment {
+	switch unit {
+	case Year:
+		return g.addCalendarMonths(gregorian, amount*12)
+	case Quarter:
+		return g.addCalendarMonths(gregorian, amount*3)
+	case Month:
+		return g.addCalendarMonths(gregorian, amount)
+	case JalaliYear:
+		return g.addCalendarMonths(jalali, amount*12)
+	case JalaliMonth:
+		return g.addCalendarMonths(jalali, amount)
+	case HijriYear:
+		return g.addCalendarMonths(g.hijri, amount*12)
+	case HijriMonth:
+		return g.addCalendarMonths(g.hijri, amount)
+	}
+	return g.AddUnit(amount, unit)
+}
+
+// Split returns the Range split into a number of parts of equal length. Each part includes its start & excludes its
+// end, apart from the first & last parts which keep the inclusivity of the Range.
+func (r *Range) Split(parts int) []*Range {
+	if parts <= 0 {
+		return nil
+	}
+
+	start := r.from().ToTime()
+	length := r.to().ToTime().Sub(start)
+
+	ranges := make([]*Range, parts)
+	partStart := r.from().Clone()
+
+	for i := 0; i < parts; i++ {
+		partEnd := r.to().Clone()
+		if i < parts-1 {
+			offset := time.Duration(float64(length) * float64(i+1) / float64(parts))
+			partEnd = r.from().Clone().addDuration(offset)
+		}
+
+		ranges[i] = &Range{
+			start:          partStart,
+			end:            partEnd,
+			startInclusive: i > 0 || r.startInclusive,
+			endInclusive:   i == parts-1 && r.endInclusive,
+		}
+
+		partStart = partEnd.Clone()
+	}
+
+	return ranges
+}
+
+// from returns the start of the Range, which is the zero time for the zero Range.
+func (r *Range) from() *Goment {
+	if r.start == nil {
+		return &Goment{}
+	}
+	return r.start
+}
+
+// to returns the end of the Range, which is the zero time for the zero Range.
+func (r *Range) to() *Goment {
+	if r.end == nil {
+		return &Goment{}
+	}
+	return r.end
+}
+
+func (r *Range) clone() *Range {
+	return &Range{
+		start:          r.from().Clone(),
+		end:            r.to().Clone(),
+		startInclusive: r.startInclusive,
+		endInclusive:   r.endInclusive,
+	}
+}
+
+func (r *Range) afterStart(t time.Time) bool {
+	start := r.from().ToTime()
+	return t.After(start) || (r.startInclusive && t.Equal(start))
+}
+
+func (r *Range) beforeEnd(t time.Time) bool {
+	end := r.to().ToTime()
+	return t.Before(end) || (r.endInclusive && t.Equal(end))
+}
+
+func (r *Range) containsRange(other *Range) bool {
+	if other.IsEmpty() {
+		return true
+	}
+	return compareStarts(other.from(), other.startInclusive, r.from(), r.startInclusive) >= 0 &&
+		compareEnds(other.to(), other.endInclusive, r.to(), r.endInclusive) <= 0
+}
+
+// isAdjacent checks if the Range ends where another Range starts, with no gap between them.
+func (r *Range) isAdjacent(other *Range) bool {
+	return r.to().ToTime().Equal(other.from().ToTime()) && (r.endInclusive || other.startInclusive)
+}
+
+// compareStarts compares the starts of two ranges, returning -1 if the first starts earlier, 1 if it starts later,
+// or 0 if they start at the same point. An excluded start is later than an included start at the same time.
+func compareStarts(a *Goment, aInclusive bool, b *Goment, bInclusive bool) int {
+	if c := compareTimes(a.ToTime(), b.ToTime()); c != 0 {
+		return c
+	}
+	if aInclusive == bInclusive {
+		return 0
+	}
+	if aInclusive {
+		return -1
+	}
+	return 1
+}
+
+// compareEnds compares the ends of two ranges, returning -1 if the first ends earlier, 1 if it ends later, or 0 if
+// they end at the same point. An excluded end is earlier than an included end at the same time.
+func compareEnds(a *Goment, aInclusive bool, b *Goment, bInclusive bool) int {
+	if c := compareTimes(a.ToTime(), b.ToTime()); c != 0 {
+		return c
+	}
+	if aInclusive == bInclusive {
+		return 0
+	}
+	if aInclusive {
+		return 1
+	}
+	return -1
+}
+
+func compareTimes(a, b time.Time) int {
+	if a.Before(b) {
+		return -1
+	}
+	if a.After(b) {
+		return 1
+	}
+	return 0
+}
+
+func isEmptyRange(start, end time.Time, startInclusive, endInclusive bool) bool {
+	return start.After(end) || (start.Equal(end) && !(startInclusive && endInclusive))
+}
+
+func newRangeOrNil(start, end *Goment, startInclusive, endInclusive bool) *Range {
+	if isEmptyRange(start.ToTime(), end.ToTime(), startInclusive, endInclusive) {
+		return nil
+	}
+	return &Range{
+		start:          start.Clone(),
+		end:            end.Clone(),
+		startInclusive: startInclusive,
+		endInclusive:   endInclusive,
+	}
+}
@@ -0,0 +1,201 @@
+package goment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func simpleRange(start, end string, inclusivity ...string) *Range {
+	r, _ := NewRange(start, end, inclusivity...)
+	return r
+}
+
+func formatRanges(ranges []*Range) []string {
+	formatted := []string{}
+	for _, r := range ranges {
+		formatted = append(formatted, formatRange(r))
+	}
+	return formatted
+}
+
+func formatRange(r *Range) string {
+	if r == nil {
+		return "nil"
+	}
+	inclusivity := r.Inclusivity()
+	return string(inclusivity[0]) + r.Start().Format("YYYY-MM-DD") + "," + r.End().Format("YYYY-MM-DD") + string(inclusivity[1])
+}
+
+func TestNewRange(t *testing.T) {
+	assert := assert.New(t)
+
+	r, err := NewRange("2020-01-01", time.Date(2020, 1, 31, 0, 0, 0, 0, time.UTC))
+	assert.Nil(err)
+	assert.Equal("[2020-01-01,2020-01-31]", formatRange(r))
+
+	r, err = NewRange("2020-01-01", "2020-01-31", "[)")
+	assert.Nil(err)
+	assert.Equal("[)", r.Inclusivity())
+
+	_, err = NewRange("2020-01-31", "2020-01-01")
+	assert.EqualError(err, "Range start must not be after the end")
+
+	_, err = NewRange("2020-01-01", "2020-01-31", "[[")
+	assert.EqualError(err, "Inclusivity must be one of (), [), (] or []")
+
+	_, err = NewRange("not a date", "2020-01-31")
+	assert.EqualError(err, "Not a matching ISO-8601 date")
+
+	start := simpleString("2020-01-01")
+	r, _ = NewRange(start, "2020-01-31")
+	start.Add(1, "year")
+	r.Start().Add(1, "year")
+	assert.Equal("[2020-01-01,2020-01-31]", formatRange(r))
+}
+
+func TestInvalidRangeIsEmpty(t *testing.T) {
+	assert := assert.New(t)
+
+	r, err := NewRange("not a date", "2020-01-31")
+	assert.Error(err)
+
+	other := simpleRange("2020-01-01", "2020-01-31")
+	for _, r := range []*Range{r, {}} {
+		assert.True(r.IsEmpty())
+		assert.False(r.Contains("2020-01-15"))
+		assert.True(other.Contains(r))
+		assert.False(r.Overlaps(other))
+		assert.Nil(r.Intersect(other))
+		assert.Nil(r.Union(other))
+		assert.Equal([]*Range{}, r.Subtract(other))
+		assert.Equal([]string{"[2020-01-01,2020-01-31]"}, formatRanges(other.Subtract(r)))
+		assert.Empty(r.By("day", 1))
+		assert.Len(r.Split(2), 2)
+		assert.Equal(0.0, r.Duration().As("seconds"))
+		assert.Equal("0001-01-01", r.Start().Format("YYYY-MM-DD"))
+	}
+}
+
+func TestRangeIsEmpty(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.False(simpleRange("2020-01-01", "2020-01-01").IsEmpty())
+	assert.True(simpleRange("2020-01-01", "2020-01-01", "[)").IsEmpty())
+	assert.False(simpleRange("2020-01-01", "2020-01-02", "()").IsEmpty())
+}
+
+func TestRangeContains(t *testing.T) {
+	assert := assert.New(t)
+
+	r := simpleRange("2020-01-01", "2020-01-31", "[)")
+
+	assert.True(r.Contains("2020-01-01"))
+	assert.True(r.Contains("2020-01-15T12:00:00Z"))
+	assert.False(r.Contains("2020-01-31"))
+	assert.False(r.Contains("2019-12-31"))
+	assert.False(r.Contains("not a date"))
+
+	assert.True(r.Contains(simpleRange("2020-01-01", "2020-01-31", "[)")))
+	assert.True(r.Contains(simpleRange("2020-01-10", "2020-01-20")))
+	assert.False(r.Contains(simpleRange("2020-01-10", "2020-01-31")))
+	assert.False(r.Contains(*simpleRange("2019-12-10", "2020-01-20")))
+}
+
+func TestRangeOverlapsAndIntersect(t *testing.T) {
+	assert := assert.New(t)
+
+	r := simpleRange("2020-01-01", "2020-01-31")
+
+	assert.True(r.Overlaps(simpleRange("2020-01-31", "2020-02-28")))
+	assert.False(r.Overlaps(simpleRange("2020-01-31", "2020-02-28", "()")))
+	assert.False(simpleRange("2020-01-01", "2020-01-31", "[)").Overlaps(simpleRange("2020-01-31", "2020-02-28")))
+	assert.False(r.Overlaps(simpleRange("2020-02-01", "2020-02-28")))
+
+	assert.Equal("[2020-01-15,2020-01-31]", formatRange(r.Intersect(simpleRange("2020-01-15", "2020-02-28"))))
+	assert.Equal("(2020-01-15,2020-01-20)", formatRange(r.Intersect(simpleRange("2020-01-15", "2020-01-20", "()"))))
+	assert.Equal("[2020-01-31,2020-01-31]", formatRange(r.Intersect(simpleRange("2020-01-31", "2020-02-28"))))
+	assert.Equal("nil", formatRange(r.Intersect(simpleRange("2020-02-01", "2020-02-28"))))
+}
+
+func TestRangeUnion(t *testing.T) {
+	assert := assert.New(t)
+
+	r := simpleRange("2020-01-01", "2020-01-31", "[)")
+
+	assert.Equal("[2020-01-01,2020-02-28]", formatRange(r.Union(simpleRange("2020-01-15", "2020-02-28"))))
+	assert.Equal("[2020-01-01,2020-02-28]", formatRange(r.Union(simpleRange("2020-01-31", "2020-02-28"))))
+	assert.Equal("[2019-12-01,2020-01-31)", formatRange(r.Union(simpleRange("2019-12-01", "2020-01-01", "[)"))))
+	assert.Equal("nil", formatRange(r.Union(simpleRange("2020-01-31", "2020-02-28", "()"))))
+	assert.Equal("nil", formatRange(r.Union(simpleRange("2020-02-01", "2020-02-28"))))
+}
+
+func TestRangeSubtract(t *testing.T) {
+	assert := assert.New(t)
+
+	r := simpleRange("2020-01-01", "2020-01-31")
+
+	assert.Equal([]string{"[2020-01-01,2020-01-10)", "(2020-01-20,2020-01-31]"}, formatRanges(r.Subtract(simpleRange("2020-01-10", "2020-01-20"))))
+	assert.Equal([]string{"[2020-01-01,2020-01-10]", "[2020-01-20,2020-01-31]"}, formatRanges(r.Subtract(simpleRange("2020-01-10", "2020-01-20", "()"))))
+	assert.Equal([]string{"[2020-01-01,2020-01-15)"}, formatRanges(r.Subtract(simpleRange("2020-01-15", "2020-02-28"))))
+	assert.Equal([]string{}, formatRanges(r.Subtract(simpleRange("2019-01-01", "2020-02-28"))))
+	assert.Equal([]string{"[2020-01-01,2020-01-31]"}, formatRanges(r.Subtract(simpleRange("2020-02-01", "2020-02-28"))))
+	assert.Equal([]string{"(2020-01-01,2020-01-31]"}, formatRanges(r.Subtract(simpleRange("2020-01-01", "2020-01-01"))))
+}
+
+func TestRangeDuration(t *testing.T) {
+	assert := assert.New(t)
+
+	d := simpleRange("2020-01-01", "2020-01-02T06:00:00Z").Duration()
+	assert.Equal(30.0, d.As("hours"))
+}
+
+func TestRangeBy(t *testing.T) {
+	assert := assert.New(t)
+
+	formatGoments := func(goments []*Goment) []string {
+		formatted := []string{}
+		for _, g := range goments {
+			formatted = append(formatted, g.Format("YYYY-MM-DD"))
+		}
+		return formatted
+	}
+
+	r := simpleRange("2020-01-01", "2020-01-05")
+	assert.Equal([]string{"2020-01-01", "2020-01-02", "2020-01-03", "2020-01-04", "2020-01-05"}, formatGoments(r.By("day", 1)))
+	assert.Equal([]string{"2020-01-01", "2020-01-03", "2020-01-05"}, formatGoments(r.By(Day, 2)))
+
+	r = simpleRange("2020-01-01", "2020-01-05", "()")
+	assert.Equal([]string{"2020-01-02", "2020-01-03", "2020-01-04"}, formatGoments(r.By("days", 1)))
+
+	r = simpleRange("2020-01-31", "2020-05-31")
+	assert.Equal([]string{"2020-01-31", "2020-03-31", "2020-05-31"}, formatGoments(r.By(Month, 2)))
+
+	// Steps from the end of a month are pinned to the end of shorter months.
+	r = simpleRange("2024-01-31", "2024-06-30")
+	assert.Equal([]string{"2024-01-31", "2024-02-29", "2024-03-31", "2024-04-30", "2024-05-31", "2024-06-30"}, formatGoments(r.By("month", 1)))
+	assert.Equal([]string{"2024-01-31", "2024-04-30"}, formatGoments(r.By(Quarter, 1)))
+
+	r = simpleRange("2024-02-29", "2028-02-29")
+	assert.Equal([]string{"2024-02-29", "2025-02-28", "2026-02-28", "2027-02-28", "2028-02-29"}, formatGoments(r.By("year", 1)))
+
+	r = simpleRange("2024-09-21", "2024-11-21")
+	assert.Equal([]string{"2024-09-21", "2024-10-21", "2024-11-20"}, formatGoments(r.By(JalaliMonth, 1)))
+
+	assert.Nil(r.By("dya", 1))
+	assert.Nil(r.By("day", 0))
+}
+
+func TestRangeSplit(t *testing.T) {
+	assert := assert.New(t)
+
+	r := simpleRange("2020-01-01", "2020-01-07")
+	assert.Equal([]string{"[2020-01-01,2020-01-03)", "[2020-01-03,2020-01-05)", "[2020-01-05,2020-01-07]"}, formatRanges(r.Split(3)))
+
+	parts := simpleRange("2020-01-01", "2020-01-02").Split(4)
+	assert.Equal("2020-01-01T06:00:00+00:00", parts[1].Start().Format())
+	assert.Equal("2020-01-02T00:00:00+00:00", parts[3].End().Format())
+
+	assert.Nil(r.Split(0))
+}
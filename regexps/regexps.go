@@ -6,17 +6,41 @@ import "regexp"
 var LocaleRegex = regexp.MustCompile(`(\[[^\[]*\])|(\\)?(LT[S]?|LL?L?L?|l{1,4})`)
 
 // TokenRegex is used to parse tokens out of formats.
-var TokenRegex = regexp.MustCompile(`(\[[^\[]*\])|(\\)?([Hh]mm(ss)?|Mo|MM?M?M?|Do|DDDo|DD?D?D?|ddd?d?|do?|w[o|w]?|W[o|W]?|Qo?|YYYYYY|YYYYY|YYYY|YY|y{2,4}|yo?|gg(ggg?)?|GG(GGG?)?|e|E|a|A|hh?|HH?|kk?|mm?|ss?|SS?S?|X|zz?zz?|ZZ?|.)`)
+var TokenRegex = regexp.MustCompile(`(\[[^\[]*\])|(\\)?([ji](?:YYYY|YY|M{1,4}|D{1,4})|[Hh]mm(ss)?|Mo|MM?M?M?|Do|DDDo|DD?D?D?|ddd?d?|do?|w[o|w]?|W[o|W]?|Qo?|YYYYYY|YYYYY|YYYY|YY|y{2,4}|yo?|gg(ggg?)?|GG(GGG?)?|e|E|a|A|hh?|HH?|kk?|mm?|ss?|S{1,9}|X|zz?zz?|ZZ?|.)`)
+
+// MonthsIsFormatRegex is used to find formats with a month name after a day of the month, like "D MMMM".
+var MonthsIsFormatRegex = regexp.MustCompile(`D[oD]?(\[[^\[\]]*\]|\s)+MMMM?`)
 
 // BracketRegex is used to find brackets in formats.
 var BracketRegex = regexp.MustCompile(`\[([^\[\]]*)\]`)
 
 // BasicISORegex is used to parse simple ISO 8601 dates.
-var BasicISORegex = regexp.MustCompile(`^\s*((?:[+-]\d{6}|\d{4})(?:\d\d\d\d|W\d\d\d|W\d\d|\d\d\d|\d\d))(?:(T| )(\d\d(?:\d\d(?:\d\d(?:[.,]\d+)?)?)?)([\+\-]\d\d(?::?\d\d)?|\s*Z)?)?$`)
+var BasicISORegex = regexp.MustCompile(`^\s*((?:[+-]\d{6}|\d{4})(?:\d\d\d\d|W\d\d\d|W\d\d|\d\d\d|\d\d))(?:(T| )(\d\d(?:[.,]\d+|\d\d(?:[.,]\d+|\d\d(?:[.,]\d+)?)?)?)([\+\-]\d\d(?::?\d\d)?|\s*Z)?)?$`)
 
 // ExtendedISORegex is used to parse extends ISO 8601 dates.
-// 0000-00-00 0000-W00 or 0000-W00-0 + T + 00 or 00:00 or 00:00:00 or 00:00:00.000 + +00:00 or +0000 or +00)
-var ExtendedISORegex = regexp.MustCompile(`^\s*((?:[+-]\d{6}|\d{4})-(?:\d\d-\d\d|W\d\d-\d|W\d\d|\d\d\d|\d\d))(?:(T| )(\d\d(?::\d\d(?::\d\d(?:[.,]\d+)?)?)?)([\+\-]\d\d(?::?\d\d)?|\s*Z)?)?$`)
+// 0000-00-00 0000-W00 or 0000-W00-0 + T + 00 or 00:00 or 00:00:00, with a fraction of the last, + +00:00 or +0000 or +00)
+var ExtendedISORegex = regexp.MustCompile(`^\s*((?:[+-]\d{6}|\d{4})-(?:\d\d-\d\d|W\d\d-\d|W\d\d|\d\d\d|\d\d))(?:(T| )(\d\d(?:[.,]\d+|:\d\d(?:[.,]\d+|:\d\d(?:[.,]\d+)?)?)?)([\+\-]\d\d(?::?\d\d)?|\s*Z)?)?$`)
+
+// RFC2822Regex is used to parse RFC 2822 dates, after comments are removed & whitespace is folded.
+var RFC2822Regex = regexp.MustCompile(`^(?:(Mon|Tue|Wed|Thu|Fri|Sat|Sun),?\s)?(\d{1,2})\s(Jan|Feb|Mar|Apr|May|Jun|Jul|Aug|Sep|Oct|Nov|Dec)\s(\d{2,4})\s(\d\d):(\d\d)(?::(\d\d))?\s(?:(UT|GMT|[ECMP][SD]T)|([A-IK-Za-ik-z])|([+-]\d{4}))$`)
+
+// ISODurationRegex is used to parse ISO 8601 durations, like P1Y2M10DT2H30M, PT0.5S or P3W.
+var ISODurationRegex = regexp.MustCompile(`^([+-])?P(?:([+-]?\d+(?:[.,]\d+)?)Y)?(?:([+-]?\d+(?:[.,]\d+)?)M)?(?:([+-]?\d+(?:[.,]\d+)?)W)?(?:([+-]?\d+(?:[.,]\d+)?)D)?(?:T(?:([+-]?\d+(?:[.,]\d+)?)H)?(?:([+-]?\d+(?:[.,]\d+)?)M)?(?:([+-]?\d+(?:[.,]\d+)?)S)?)?$`)
+
+// HolidayFixedRegex is used to parse fixed date holiday rules, like 12-25.
+var HolidayFixedRegex = regexp.MustCompile(`^(\d{1,2})-(\d{1,2})$`)
+
+// HolidayWeekdayRegex is used to parse weekday holiday rules, like 4th Thursday of November or last Monday of May.
+var HolidayWeekdayRegex = regexp.MustCompile(`(?i)^(1st|2nd|3rd|4th|5th|first|second|third|fourth|fifth|last)\s+([a-z]+)\s+of\s+([a-z]+)$`)
+
+// HolidayEasterRegex is used to parse Easter holiday rules, like Easter, Easter+1 or Orthodox Easter-2.
+var HolidayEasterRegex = regexp.MustCompile(`(?i)^(orthodox\s+)?easter\s*(?:([+-]\s*\d+))?$`)
+
+// RFC2822CommentRegex is used to find comments in RFC 2822 dates.
+var RFC2822CommentRegex = regexp.MustCompile(`\([^()]*\)`)
+
+// WhitespaceRegex is used to find runs of whitespace.
+var WhitespaceRegex = regexp.MustCompile(`\s+`)
 
 // TimeZoneRegex is used to parse timezones.
 var TimeZoneRegex = regexp.MustCompile(`Z|[+-]\d\d(?::?\d\d)?`)
@@ -33,6 +57,12 @@ var MatchTwo = regexp.MustCompile(`\d\d`)
 // MatchOneToFour is used to match one to four digits.
 var MatchOneToFour = regexp.MustCompile(`\d{1,4}`)
 
+// MatchFour is used to match exactly 4 digits.
+var MatchFour = regexp.MustCompile(`\d{4}`)
+
+// MatchSix is used to match exactly 6 digits, with an optional sign.
+var MatchSix = regexp.MustCompile(`[+-]?\d{6}`)
+
 // MatchOneToSix is used to match one to 6 digits.
 var MatchOneToSix = regexp.MustCompile(`[+-]?\d{1,6}`)
 
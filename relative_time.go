@@ -1,12 +1,13 @@
 package goment
 
 import (
+	"errors"
 	"math"
 
 	"github.com/nleeper/goment/locales"
 )
 
-var thresholds = map[string]int{
+var defaultThresholds = map[string]int{
 	"ss": 44, // a few seconds to seconds
 	"s":  45, // seconds to minute
 	"m":  45, // minutes to hour
@@ -15,23 +16,85 @@ var thresholds = map[string]int{
 	"M":  11, // months to year
 }
 
-// ToNow returns the relative time to now to the Goment time.
-func (g *Goment) ToNow(args ...interface{}) string {
-	withoutSuffix := false
+// relativeTimeThresholdUnits are the units that can have a threshold. Weeks are only used when they have one.
+var relativeTimeThresholdUnits = map[string]bool{"ss": true, "s": true, "m": true, "h": true, "d": true, "w": true, "M": true}
+
+var thresholds = copyThresholds(defaultThresholds)
+
+var relativeTimeRounding = roundTime
+
+// RelativeTimeOptions change how a relative time is displayed by From, To, FromNow, ToNow & Duration Humanize.
+type RelativeTimeOptions struct {
+	// WithoutSuffix leaves out the future or past suffix, like "a month" instead of "in a month".
+	WithoutSuffix bool
+	// Thresholds replace the global thresholds for the units they contain.
+	Thresholds map[string]int
+	// Rounding replaces the global rounding when it is not nil.
+	Rounding func(float64) float64
+}
+
+// relativeTime is the thresholds & rounding used to display a relative time.
+type relativeTime struct {
+	withoutSuffix bool
+	thresholds    map[string]int
+	rounding      func(float64) int
+}
+
+// RelativeTimeThreshold returns the global threshold for a unit, which is one of ss, s, m, h, d, w & M. False is
+// returned if the unit does not have a threshold, which is the default for weeks.
+func RelativeTimeThreshold(unit string) (int, bool) {
+	limit, ok := thresholds[unit]
+	return limit, ok
+}
+
+// SetRelativeTimeThreshold sets the global threshold for a unit, which is the number of the unit before the next
+// larger unit is used. The ss threshold is the number of seconds that are "a few seconds". Setting the s threshold
+// also sets the ss threshold to one less. Weeks are used when the w threshold is more than 0, which also needs the d
+// threshold to be 7 or less for days to move to weeks. An error is returned if the unit can't have a threshold.
+func SetRelativeTimeThreshold(unit string, limit int) error {
+	if !relativeTimeThresholdUnits[unit] {
+		return errors.New("Invalid relative time threshold " + unit)
+	}
+
+	thresholds[unit] = limit
+	if unit == "s" {
+		thresholds["ss"] = limit - 1
+	}
+
+	return nil
+}
+
+// ResetRelativeTimeThresholds sets the global thresholds back to their defaults.
+func ResetRelativeTimeThresholds() {
+	thresholds = copyThresholds(defaultThresholds)
+}
+
+// SetRelativeTimeRounding sets the global function used to round the lengths of time in a relative time, like
+// math.Floor. Lengths are rounded to the nearest number by default, which is used again when the function is nil.
+func SetRelativeTimeRounding(rounding func(float64) float64) {
+	if rounding == nil {
+		relativeTimeRounding = roundTime
+		return
+	}
 
-	if len(args) >= 1 {
-		withoutSuffix = args[0].(bool)
+	relativeTimeRounding = func(input float64) int {
+		return int(rounding(input))
 	}
+}
 
+// ToNow returns the relative time to now to the Goment time. Pass true, or RelativeTimeOptions, to change how it is
+// displayed.
+func (g *Goment) ToNow(args ...interface{}) string {
 	now, err := New()
 	if err != nil {
 		return ""
 	}
 
-	return g.To(now, withoutSuffix)
+	return g.To(append([]interface{}{now}, args...)...)
 }
 
-// To returns the relative time from the Goment time to the supplied time.
+// To returns the relative time from the Goment time to the supplied time. Pass true after the time to leave out the
+// suffix, or RelativeTimeOptions to change how it is displayed.
 func (g *Goment) To(args ...interface{}) string {
 	var to *Goment
 	var err error
@@ -48,33 +111,24 @@ func (g *Goment) To(args ...interface{}) string {
 			}
 		}
 
-		withoutSuffix := false
-		if numArgs > 1 {
-			withoutSuffix = args[1].(bool)
-		}
-
-		return humanize(to, g, withoutSuffix, g.locale)
+		return humanize(to, g, newRelativeTime(args[1:]), g.locale)
 	}
 	return ""
 }
 
-// FromNow returns the relative time from now to the Goment time.
+// FromNow returns the relative time from now to the Goment time. Pass true, or RelativeTimeOptions, to change how it
+// is displayed.
 func (g *Goment) FromNow(args ...interface{}) string {
-	withoutSuffix := false
-
-	if len(args) >= 1 {
-		withoutSuffix = args[0].(bool)
-	}
-
 	now, err := New()
 	if err != nil {
 		return ""
 	}
 
-	return g.From(now, withoutSuffix)
+	return g.From(append([]interface{}{now}, args...)...)
 }
 
-// From returns the relative time from the supplied time to the Goment time.
+// From returns the relative time from the supplied time to the Goment time. Pass true after the time to leave out
+// the suffix, or RelativeTimeOptions to change how it is displayed.
 func (g *Goment) From(args ...interface{}) string {
 	var from *Goment
 	var err error
@@ -91,12 +145,7 @@ func (g *Goment) From(args ...interface{}) string {
 			}
 		}
 
-		withoutSuffix := false
-		if numArgs > 1 {
-			withoutSuffix = args[1].(bool)
-		}
-
-		return humanize(g, from, withoutSuffix, g.locale)
+		return humanize(g, from, newRelativeTime(args[1:]), g.locale)
 	}
 	return ""
 }
@@ -110,9 +159,9 @@ func (g *Goment) Calendar(args ...interface{}) string {
 	case 0:
 		refTime, err = New()
 	default:
-		switch v := args[1].(type) {
+		switch v := args[0].(type) {
 		case *Goment:
-			refTime = v
+			refTime = v.Clone()
 		default:
 			refTime, err = New(v)
 		}
@@ -161,20 +210,67 @@ func getCalendarFormat(g *Goment, sod *Goment) string {
 	return "sameElse"
 }
 
-func humanize(to *Goment, from *Goment, withoutSuffix bool, locale locales.LocaleDetails) string {
-	localTo := to.Local()
-	localFrom := from.Local()
+// newRelativeTime creates a relativeTime from the global thresholds & rounding, changed by a bool to leave out the
+// suffix or RelativeTimeOptions.
+func newRelativeTime(args []interface{}) relativeTime {
+	r := relativeTime{thresholds: thresholds, rounding: relativeTimeRounding}
+
+	for _, arg := range args {
+		switch v := arg.(type) {
+		case bool:
+			r.withoutSuffix = v
+		case RelativeTimeOptions:
+			r.withoutSuffix = r.withoutSuffix || v.WithoutSuffix
+			if v.Rounding != nil {
+				rounding := v.Rounding
+				r.rounding = func(input float64) int {
+					return int(rounding(input))
+				}
+			}
+			if len(v.Thresholds) > 0 {
+				r.thresholds = copyThresholds(thresholds)
+				for unit, limit := range v.Thresholds {
+					r.thresholds[unit] = limit
+				}
+				if _, ok := v.Thresholds["ss"]; !ok && v.Thresholds["s"] > 0 {
+					r.thresholds["ss"] = v.Thresholds["s"] - 1
+				}
+			}
+		}
+	}
+
+	return r
+}
+
+// roundAndAbs rounds the size of a length of time.
+func (r relativeTime) roundAndAbs(num float64) int {
+	return r.rounding(math.Abs(num))
+}
+
+func humanize(to *Goment, from *Goment, r relativeTime, locale locales.LocaleDetails) string {
+	localTo := to.Clone().Local()
+	localFrom := from.Clone().Local()
 
 	past := localTo.IsBefore(localFrom)
 
 	diff := localFrom.ToTime().Sub(localTo.ToTime())
 
-	seconds := roundAndAbs(diff.Seconds())
-	hours := roundAndAbs(diff.Hours())
-	minutes := roundAndAbs(diff.Minutes())
-	days := roundAndAbs(divideSeconds(seconds, 86400))
-	months := roundAndAbs(divideSeconds(seconds, 2600640))
-	years := roundAndAbs(divideSeconds(seconds, 31207680))
+	seconds := r.roundAndAbs(diff.Seconds())
+	hours := r.roundAndAbs(diff.Hours())
+	minutes := r.roundAndAbs(diff.Minutes())
+	days := r.roundAndAbs(divideSeconds(seconds, 86400))
+	weeks := r.roundAndAbs(divideSeconds(seconds, 604800))
+	months := r.roundAndAbs(divideSeconds(seconds, 2600640))
+	years := r.roundAndAbs(divideSeconds(seconds, 31207680))
+
+	format, number := r.format(seconds, minutes, hours, days, weeks, months, years)
+
+	return locale.RelativeTime(format, number, r.withoutSuffix, past)
+}
+
+// format returns the relative time format & number to use for a length of time, using the thresholds.
+func (r relativeTime) format(seconds, minutes, hours, days, weeks, months, years int) (string, int) {
+	thresholds := r.thresholds
 
 	format := "yy"
 	number := years
@@ -192,6 +288,17 @@ func humanize(to *Goment, from *Goment, withoutSuffix bool, locale locales.Local
 		format = "M"
 	}
 
+	if thresholds["w"] > 0 {
+		if weeks < thresholds["w"] {
+			format = "ww"
+			number = weeks
+		}
+
+		if weeks <= 1 {
+			format = "w"
+		}
+	}
+
 	if days < thresholds["d"] {
 		format = "dd"
 		number = days
@@ -229,11 +336,15 @@ func humanize(to *Goment, from *Goment, withoutSuffix bool, locale locales.Local
 		number = seconds
 	}
 
-	return locale.RelativeTime(format, number, withoutSuffix, past)
+	return format, number
 }
 
-func roundAndAbs(num float64) int {
-	return abs(roundTime(num))
+func copyThresholds(t map[string]int) map[string]int {
+	c := map[string]int{}
+	for unit, limit := range t {
+		c[unit] = limit
+	}
+	return c
 }
 
 func divideSeconds(seconds int, divisor int) float64 {
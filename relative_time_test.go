@@ -1,6 +1,7 @@
 package goment
 
 import (
+	"math"
 	"testing"
 	"time"
 
@@ -265,6 +266,165 @@ func TestToRelativeTime(t *testing.T) {
 	assert.Equal("5 years", lib.To(simpleTime(testTime).Add(5, "y"), true), "5 years = 5 years")
 }
 
+func TestRelativeTimeDoesNotChangeGoments(t *testing.T) {
+	assert := assert.New(t)
+
+	lib := simpleTime(time.Date(2000, 12, 15, 17, 8, 0, 0, chicagoLocation()))
+	other := simpleTime(time.Date(2000, 12, 15, 19, 8, 0, 0, time.UTC))
+
+	assert.Equal("in 4 hours", lib.From(other))
+	assert.Equal("4 hours ago", lib.To(other))
+	assert.Equal(chicagoLocation(), lib.ToTime().Location())
+	assert.Equal(time.UTC, other.ToTime().Location())
+}
+
+func TestRelativeTimeThreshold(t *testing.T) {
+	assert := assert.New(t)
+	defer ResetRelativeTimeThresholds()
+
+	other := simpleTime(time.Date(2000, 12, 15, 17, 8, 0, 0, time.UTC))
+	lib := other.Clone().Subtract(44, "seconds")
+
+	limit, ok := RelativeTimeThreshold("ss")
+	assert.True(ok)
+	assert.Equal(44, limit)
+
+	assert.Nil(SetRelativeTimeThreshold("ss", 3))
+	assert.Equal("44 seconds ago", lib.From(other))
+	assert.Equal("a few seconds ago", other.Clone().Subtract(3, "seconds").From(other))
+
+	// Setting the seconds threshold also sets the few seconds threshold.
+	assert.Nil(SetRelativeTimeThreshold("s", 30))
+	limit, _ = RelativeTimeThreshold("ss")
+	assert.Equal(29, limit)
+	assert.Equal("a minute ago", lib.From(other))
+
+	assert.Nil(SetRelativeTimeThreshold("m", 60))
+	assert.Equal("59 minutes ago", other.Clone().Subtract(59, "minutes").From(other))
+
+	assert.EqualError(SetRelativeTimeThreshold("x", 1), "Invalid relative time threshold x")
+	_, ok = RelativeTimeThreshold("x")
+	assert.False(ok)
+
+	ResetRelativeTimeThresholds()
+	assert.Equal("a few seconds ago", lib.From(other))
+}
+
+func TestRelativeTimeRounding(t *testing.T) {
+	assert := assert.New(t)
+	defer SetRelativeTimeRounding(nil)
+
+	other := simpleTime(time.Date(2000, 12, 15, 17, 8, 0, 0, time.UTC))
+	lib := other.Clone().Subtract(30*time.Minute + 40*time.Second)
+
+	assert.Equal("31 minutes ago", lib.From(other))
+	assert.Equal("in 31 minutes", other.From(lib))
+
+	SetRelativeTimeRounding(math.Floor)
+	assert.Equal("30 minutes ago", lib.From(other))
+	assert.Equal("in 30 minutes", other.From(lib))
+
+	SetRelativeTimeRounding(nil)
+	assert.Equal("31 minutes ago", lib.From(other))
+}
+
+func TestRelativeTimeOptions(t *testing.T) {
+	assert := assert.New(t)
+
+	testTime := time.Date(2000, 12, 15, 17, 8, 0, 0, time.UTC)
+	timeNow = func() time.Time {
+		return testTime
+	}
+	defer func() {
+		timeNow = time.Now
+	}()
+
+	lib := simpleTime(testTime).Subtract(44, "seconds")
+	seconds := RelativeTimeOptions{Thresholds: map[string]int{"ss": 0}}
+
+	assert.Equal("44 seconds ago", lib.FromNow(seconds))
+	assert.Equal("a few seconds ago", lib.FromNow())
+	assert.Equal("in 44 seconds", lib.ToNow(seconds))
+	assert.Equal("44 seconds", lib.FromNow(RelativeTimeOptions{WithoutSuffix: true, Thresholds: map[string]int{"ss": 0}}))
+	assert.Equal("44 seconds", lib.From(simpleTime(testTime), true, seconds))
+	assert.Equal("44 seconds", lib.To(simpleTime(testTime), seconds, true))
+
+	// Setting the seconds threshold also sets the few seconds threshold, unless it is set too.
+	assert.Equal("a few seconds ago", lib.FromNow(RelativeTimeOptions{Thresholds: map[string]int{"s": 50}}))
+	assert.Equal("44 seconds ago", lib.FromNow(RelativeTimeOptions{Thresholds: map[string]int{"s": 50, "ss": 10}}))
+	assert.Equal("a minute ago", lib.FromNow(RelativeTimeOptions{Thresholds: map[string]int{"s": 40}}))
+
+	lib = simpleTime(testTime).Subtract(30*time.Minute + 40*time.Second)
+	assert.Equal("30 minutes ago", lib.FromNow(RelativeTimeOptions{Rounding: math.Floor}))
+	assert.Equal("31 minutes ago", lib.FromNow())
+
+	d := simpleDuration(44, "seconds")
+	assert.Equal("44 seconds", d.Humanize(seconds))
+	assert.Equal("in 44 seconds", d.Humanize(true, seconds))
+	assert.Equal("a few seconds", d.Humanize())
+}
+
+func TestRelativeTimeWeeks(t *testing.T) {
+	assert := assert.New(t)
+	defer ResetRelativeTimeThresholds()
+
+	other := simpleTime(time.Date(2000, 12, 15, 17, 8, 0, 0, time.UTC))
+	days := func(n int) *Goment {
+		return other.Clone().Subtract(n, "days")
+	}
+
+	// Weeks are not used by default.
+	assert.Equal("20 days ago", days(20).From(other))
+	_, ok := RelativeTimeThreshold("w")
+	assert.False(ok)
+
+	assert.Nil(SetRelativeTimeThreshold("w", 4))
+	assert.Nil(SetRelativeTimeThreshold("d", 7))
+
+	assert.Equal("6 days ago", days(6).From(other))
+	assert.Equal("a week ago", days(7).From(other))
+	assert.Equal("a week ago", days(10).From(other))
+	assert.Equal("2 weeks ago", days(11).From(other))
+	assert.Equal("3 weeks ago", days(20).From(other))
+	assert.Equal("in 3 weeks", other.From(days(20)))
+	assert.Equal("a month ago", days(26).From(other))
+	assert.Equal("3 weeks", simpleDuration(20, "days").Humanize())
+
+	// A threshold of 0 stops using weeks.
+	assert.Nil(SetRelativeTimeThreshold("w", 0))
+	assert.Equal("a month ago", days(20).From(other))
+
+	ResetRelativeTimeThresholds()
+	assert.Equal("3 weeks ago", days(20).From(other, RelativeTimeOptions{Thresholds: map[string]int{"w": 4, "d": 7}}))
+	assert.Equal("20 days ago", days(20).From(other))
+}
+
+func TestRelativeTimeWeeksLocales(t *testing.T) {
+	assert := assert.New(t)
+
+	other := simpleTime(time.Date(2000, 12, 15, 17, 8, 0, 0, time.UTC))
+	weeks := RelativeTimeOptions{WithoutSuffix: true, Thresholds: map[string]int{"w": 4, "d": 7}}
+
+	tests := map[string][]string{
+		"en":    {"a week", "3 weeks"},
+		"es":    {"una semana", "3 semanas"},
+		"fr":    {"une semaine", "3 semaines"},
+		"fa":    {"یک هفته", "3 هفته"},
+		"pt-br": {"uma semana", "3 semanas"},
+		"id":    {"seminggu", "3 minggu"},
+		"ru":    {"неделя", "3 недели"},
+	}
+
+	for code, expected := range tests {
+		week := other.Clone().Subtract(7, "days")
+		assert.Nil(week.SetLocale(code))
+		threeWeeks := other.Clone().Subtract(21, "days")
+		assert.Nil(threeWeeks.SetLocale(code))
+
+		assert.Equal(expected, []string{week.From(other, weeks), threeWeeks.From(other, weeks)}, code)
+	}
+}
+
 func TestCalendarDay(t *testing.T) {
 	assert := assert.New(t)
 
@@ -296,6 +456,11 @@ func TestCalendarDay(t *testing.T) {
 	refTime3.EndOf("day")
 	assert.Equal("Last Wednesday at 11:59 PM", refTime3.Calendar(), "Today - 2 days end of day")
 
+	refTime4 := simpleTime(testTime).Add(1, "d")
+	assert.Equal("Today at 12:00 PM", simpleTime(testTime).Add(1, "d").Calendar(refTime4), "same time as reference time")
+	assert.Equal("Yesterday at 12:00 PM", simpleTime(testTime).Calendar(refTime4), "day before reference time")
+	assert.Equal(12, refTime4.Hour(), "reference time is unchanged")
+
 	weeksAgo := simpleTime(testTime).Subtract(1, "w")
 	weeksFromNow := simpleTime(testTime).Add(1, "w")
 
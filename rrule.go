@@ -0,0 +1,1137 @@
+package goment
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxRRuleYear is the last year that recurrence rules are expanded to.
+const maxRRuleYear = 9999
+
+// rruleSearchYears is how many years without an occurrence a rule is expanded for before it is treated as ended, so
+// a rule that can never match, like week 53 in February, doesn't search until the last supported year. It covers
+// rare rules like the 29th of February on a Monday.
+const rruleSearchYears = 100
+
+type rruleFrequency int
+
+const (
+	yearly rruleFrequency = iota
+	monthly
+	weekly
+	daily
+	hourly
+	minutely
+	secondly
+)
+
+var rruleFrequencies = map[string]rruleFrequency{
+	"YEARLY":   yearly,
+	"MONTHLY":  monthly,
+	"WEEKLY":   weekly,
+	"DAILY":    daily,
+	"HOURLY":   hourly,
+	"MINUTELY": minutely,
+	"SECONDLY": secondly,
+}
+
+var rruleFrequencyNames = []string{"YEARLY", "MONTHLY", "WEEKLY", "DAILY", "HOURLY", "MINUTELY", "SECONDLY"}
+
+var rruleWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+var rruleWeekdayNames = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// rruleWeekday is a BYDAY value, like MO, or -1FR for the last Friday of the month or year.
+type rruleWeekday struct {
+	weekday time.Weekday
+	n       int
+}
+
+// rruleDate is an RDATE or EXDATE value.
+type rruleDate struct {
+	time     time.Time
+	dateOnly bool
+}
+
+// RRule is an RFC 5545 recurrence rule, with the DTSTART it starts from & any RDATE or EXDATE dates. Occurrences
+// are calculated on the wall clock of the DTSTART time zone, so a daily 9am rule stays at 9am across DST changes.
+// Rules with an HOURLY, MINUTELY or SECONDLY frequency step in elapsed time instead. The DTSTART is always the first
+// occurrence, even when it does not match the rule, which RFC 5545 leaves undefined. A rule ends when it has no
+// occurrence for 100 years.
+type RRule struct {
+	dtstart       *Goment
+	dateOnly      bool
+	floating      bool
+	freq          rruleFrequency
+	interval      int
+	count         int
+	until         time.Time
+	untilDateOnly bool
+	wkst          time.Weekday
+	wkstSet       bool
+	bySecond      []int
+	byMinute      []int
+	byHour        []int
+	byDay         []rruleWeekday
+	byMonthDay    []int
+	byYearDay     []int
+	byWeekNo      []int
+	byMonth       []int
+	bySetPos      []int
+	rdates        []rruleDate
+	exdates       []rruleDate
+}
+
+// NewRRule creates an RRule from an iCalendar recurrence string. The string can be a single rule, like
+// "FREQ=MONTHLY;BYDAY=-1FR" or "RRULE:FREQ=MONTHLY;BYDAY=-1FR", or lines with DTSTART, RRULE, RDATE & EXDATE
+// properties. A DTSTART can be passed with the same arguments as New, in which case it is used over any DTSTART
+// property.
+func NewRRule(rule string, dtstart ...interface{}) (*RRule, error) {
+	r := &RRule{interval: 1, wkst: time.Monday}
+
+	properties, err := splitRRuleProperties(rule)
+	if err != nil {
+		return &RRule{}, err
+	}
+
+	if len(dtstart) > 0 {
+		g, err := New(dtstart[0])
+		if err != nil {
+			return &RRule{}, err
+		}
+		r.dtstart = g
+		r.floating = g.ToTime().Location() == time.Local
+	}
+
+	for _, p := range properties {
+		if p.name != "DTSTART" || r.dtstart != nil {
+			continue
+		}
+		dates, err := parseRRuleDates(p, time.Local)
+		if err != nil {
+			return &RRule{}, err
+		}
+		r.dtstart, _ = New(dates[0].time)
+		r.dateOnly = dates[0].dateOnly
+		r.floating = p.params["TZID"] == "" && !strings.HasSuffix(p.value, "Z")
+	}
+
+	if r.dtstart == nil {
+		return &RRule{}, errors.New("Recurrence rule requires a DTSTART")
+	}
+
+	hasRule := false
+	for _, p := range properties {
+		switch p.name {
+		case "RRULE":
+			if hasRule {
+				return &RRule{}, errors.New("Recurrence rule can only have one RRULE")
+			}
+			hasRule = true
+			err = r.parseRule(p.value)
+		case "RDATE", "EXDATE":
+			var dates []rruleDate
+			dates, err = parseRRuleDates(p, r.location())
+			if p.name == "RDATE" {
+				r.rdates = append(r.rdates, dates...)
+			} else {
+				r.exdates = append(r.exdates, dates...)
+			}
+		}
+		if err != nil {
+			return &RRule{}, err
+		}
+	}
+
+	if !hasRule {
+		return &RRule{}, errors.New("Recurrence rule requires a FREQ")
+	}
+
+	return r, nil
+}
+
+// String returns the iCalendar representation of the RRule, with DTSTART, RRULE, RDATE & EXDATE lines.
+func (r *RRule) String() string {
+	lines := []string{"DTSTART" + r.formatDate(r.dtstart.ToTime(), r.dateOnly), "RRULE:" + r.ruleString()}
+
+	for _, d := range r.rdates {
+		lines = append(lines, "RDATE"+r.formatDate(d.time, d.dateOnly))
+	}
+	for _, d := range r.exdates {
+		lines = append(lines, "EXDATE"+r.formatDate(d.time, d.dateOnly))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// DTStart returns a copy of the first occurrence of the RRule.
+func (r *RRule) DTStart() *Goment {
+	return r.dtstart.Clone()
+}
+
+// AddRDate adds an extra occurrence to the RRule. The date accepts the same arguments as New.
+func (r *RRule) AddRDate(date interface{}) error {
+	g, err := New(date)
+	if err != nil {
+		return err
+	}
+	r.rdates = append(r.rdates, rruleDate{time: g.ToTime().In(r.location())})
+	return nil
+}
+
+// AddExDate excludes an occurrence from the RRule. The date accepts the same arguments as New.
+func (r *RRule) AddExDate(date interface{}) error {
+	g, err := New(date)
+	if err != nil {
+		return err
+	}
+	r.exdates = append(r.exdates, rruleDate{time: g.ToTime().In(r.location())})
+	return nil
+}
+
+// All returns every occurrence of the RRule. Nil is returned if the rule has no COUNT or UNTIL, as it never ends.
+func (r *RRule) All() []*Goment {
+	if r.count == 0 && r.until.IsZero() {
+		return nil
+	}
+
+	goments := []*Goment{}
+	r.iterate(time.Time{}, func(t time.Time) bool {
+		goments = append(goments, r.goment(t))
+		return true
+	})
+
+	return goments
+}
+
+// Between returns the occurrences of the RRule from a start to an end, which accept the same arguments as New. The
+// optional inclusivity works like IsBetween, and includes both the start & end by default. Nil is returned if the
+// start or end are not valid.
+func (r *RRule) Between(start, end interface{}, inclusivity ...string) []*Goment {
+	rng, err := NewRange(start, end, inclusivity...)
+	if err != nil {
+		return nil
+	}
+
+	goments := []*Goment{}
+	r.iterate(rng.from().ToTime(), func(t time.Time) bool {
+		if !rng.beforeEnd(t) {
+			return false
+		}
+		if rng.afterStart(t) {
+			goments = append(goments, r.goment(t))
+		}
+		return true
+	})
+
+	return goments
+}
+
+// After returns the first occurrence of the RRule after a date, which accepts the same arguments as New, or nil if
+// there is none. An occurrence at the date itself is returned when inclusive is true.
+func (r *RRule) After(date interface{}, inclusive bool) *Goment {
+	g, err := New(date)
+	if err != nil {
+		return nil
+	}
+	after := g.ToTime()
+
+	var next *Goment
+	r.iterate(after, func(t time.Time) bool {
+		if t.After(after) || (inclusive && t.Equal(after)) {
+			next = r.goment(t)
+			return false
+		}
+		return true
+	})
+
+	return next
+}
+
+// Before returns the last occurrence of the RRule before a date, which accepts the same arguments as New, or nil if
+// there is none. An occurrence at the date itself is returned when inclusive is true.
+func (r *RRule) Before(date interface{}, inclusive bool) *Goment {
+	g, err := New(date)
+	if err != nil {
+		return nil
+	}
+	before := g.ToTime()
+
+	var previous *Goment
+	r.iterate(time.Time{}, func(t time.Time) bool {
+		if t.After(before) || (!inclusive && t.Equal(before)) {
+			return false
+		}
+		previous = r.goment(t)
+		return true
+	})
+
+	return previous
+}
+
+func (r *RRule) location() *time.Location {
+	return r.dtstart.ToTime().Location()
+}
+
+func (r *RRule) goment(t time.Time) *Goment {
+	g := r.dtstart.Clone()
+	g.time = t
+	return g
+}
+
+// iterate calls yield with each occurrence in order, merging the RDATE dates & skipping the EXDATE dates, until
+// yield returns false or the occurrences run out. Occurrences of a rule without a COUNT can start from the period
+// before a time rather than from the DTSTART, so a zero time yields them all. Earlier occurrences may still be yielded.
+func (r *RRule) iterate(from time.Time, yield func(t time.Time) bool) {
+	it := newRRuleIterator(r)
+	if r.count == 0 && !from.IsZero() {
+		it.skipBefore(from)
+	}
+
+	rdates := make([]time.Time, len(r.rdates))
+	for i, d := range r.rdates {
+		rdates[i] = d.time
+	}
+	sortTimes(rdates)
+
+	next, ok := it.next()
+	var last time.Time
+	for {
+		var t time.Time
+		if len(rdates) > 0 && (!ok || rdates[0].Before(next)) {
+			t, rdates = rdates[0], rdates[1:]
+		} else if ok {
+			t = next
+			next, ok = it.next()
+		} else {
+			return
+		}
+
+		if t.Equal(last) || r.isExcluded(t) {
+			continue
+		}
+		last = t
+
+		if !yield(t) {
+			return
+		}
+	}
+}
+
+func (r *RRule) isExcluded(t time.Time) bool {
+	for _, d := range r.exdates {
+		if d.time.Equal(t) {
+			return true
+		}
+		if d.dateOnly {
+			y, m, day := t.In(r.location()).Date()
+			ey, em, eday := d.time.Date()
+			if y == ey && m == em && day == eday {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (r *RRule) parseRule(value string) error {
+	hasFreq := false
+
+	for _, part := range strings.Split(strings.ToUpper(value), ";") {
+		if part == "" {
+			continue
+		}
+
+		nameValue := strings.SplitN(part, "=", 2)
+		if len(nameValue) != 2 || nameValue[1] == "" {
+			return errors.New("Invalid recurrence rule part " + part)
+		}
+		name, val := nameValue[0], nameValue[1]
+
+		var err error
+		switch name {
+		case "FREQ":
+			freq, ok := rruleFrequencies[val]
+			if !ok {
+				return errors.New("Invalid FREQ value " + val)
+			}
+			r.freq = freq
+			hasFreq = true
+		case "INTERVAL":
+			r.interval, err = parseRRuleInt(name, val, 1, 0)
+		case "COUNT":
+			r.count, err = parseRRuleInt(name, val, 1, 0)
+		case "UNTIL":
+			var until rruleDate
+			until, err = parseRRuleDate(val, r.location())
+			if err == nil {
+				r.until = until.time
+				r.untilDateOnly = until.dateOnly
+				if until.dateOnly {
+					// A date UNTIL includes all of that day.
+					r.until = r.until.AddDate(0, 0, 1).Add(-time.Nanosecond)
+				}
+			}
+		case "WKST":
+			wkst, ok := rruleWeekdays[val]
+			if !ok {
+				return errors.New("Invalid WKST value " + val)
+			}
+			r.wkst = wkst
+			r.wkstSet = true
+		case "BYSECOND":
+			r.bySecond, err = parseRRuleInts(name, val, 0, 59, false)
+		case "BYMINUTE":
+			r.byMinute, err = parseRRuleInts(name, val, 0, 59, false)
+		case "BYHOUR":
+			r.byHour, err = parseRRuleInts(name, val, 0, 23, false)
+		case "BYDAY":
+			r.byDay, err = parseRRuleWeekdays(val)
+		case "BYMONTHDAY":
+			r.byMonthDay, err = parseRRuleInts(name, val, 1, 31, true)
+		case "BYYEARDAY":
+			r.byYearDay, err = parseRRuleInts(name, val, 1, 366, true)
+		case "BYWEEKNO":
+			r.byWeekNo, err = parseRRuleInts(name, val, 1, 53, true)
+		case "BYMONTH":
+			r.byMonth, err = parseRRuleInts(name, val, 1, 12, false)
+		case "BYSETPOS":
+			r.bySetPos, err = parseRRuleInts(name, val, 1, 366, true)
+		default:
+			return errors.New("Invalid recurrence rule part " + part)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if !hasFreq {
+		return errors.New("Recurrence rule requires a FREQ")
+	}
+
+	return r.validateRule()
+}
+
+// validateRule checks the combinations of rule parts that RFC 5545 does not allow.
+func (r *RRule) validateRule() error {
+	if r.count > 0 && !r.until.IsZero() {
+		return errors.New("Recurrence rule can not have both COUNT and UNTIL")
+	}
+	if len(r.byWeekNo) > 0 && r.freq != yearly {
+		return errors.New("BYWEEKNO is only valid with a YEARLY frequency")
+	}
+	if len(r.byYearDay) > 0 && (r.freq == monthly || r.freq == weekly || r.freq == daily) {
+		return errors.New("BYYEARDAY is not valid with a MONTHLY, WEEKLY or DAILY frequency")
+	}
+	if len(r.byMonthDay) > 0 && r.freq == weekly {
+		return errors.New("BYMONTHDAY is not valid with a WEEKLY frequency")
+	}
+	for _, wd := range r.byDay {
+		if wd.n != 0 && (r.freq > monthly || (r.freq == yearly && len(r.byWeekNo) > 0)) {
+			return errors.New("BYDAY ordinals are only valid with a MONTHLY or YEARLY frequency")
+		}
+	}
+	if len(r.bySetPos) > 0 && len(r.bySecond)+len(r.byMinute)+len(r.byHour)+len(r.byDay)+len(r.byMonthDay)+
+		len(r.byYearDay)+len(r.byWeekNo)+len(r.byMonth) == 0 {
+		return errors.New("BYSETPOS must be used with another BY rule part")
+	}
+	return nil
+}
+
+func (r *RRule) ruleString() string {
+	parts := []string{"FREQ=" + rruleFrequencyNames[r.freq]}
+
+	if r.interval != 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.interval))
+	}
+	if r.wkstSet {
+		parts = append(parts, "WKST="+rruleWeekdayNames[r.wkst])
+	}
+	if r.count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.count))
+	}
+	if !r.until.IsZero() {
+		parts = append(parts, "UNTIL="+r.formatUntil())
+	}
+
+	byDay := make([]string, len(r.byDay))
+	for i, wd := range r.byDay {
+		byDay[i] = rruleWeekdayNames[wd.weekday]
+		if wd.n != 0 {
+			byDay[i] = strconv.Itoa(wd.n) + byDay[i]
+		}
+	}
+
+	for _, by := range []struct {
+		name   string
+		values []string
+	}{
+		{"BYSETPOS", formatRRuleInts(r.bySetPos)},
+		{"BYMONTH", formatRRuleInts(r.byMonth)},
+		{"BYMONTHDAY", formatRRuleInts(r.byMonthDay)},
+		{"BYYEARDAY", formatRRuleInts(r.byYearDay)},
+		{"BYWEEKNO", formatRRuleInts(r.byWeekNo)},
+		{"BYDAY", byDay},
+		{"BYHOUR", formatRRuleInts(r.byHour)},
+		{"BYMINUTE", formatRRuleInts(r.byMinute)},
+		{"BYSECOND", formatRRuleInts(r.bySecond)},
+	} {
+		if len(by.values) > 0 {
+			parts = append(parts, by.name+"="+strings.Join(by.values, ","))
+		}
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// formatDate formats a DTSTART, RDATE or EXDATE value with its parameters, like ";TZID=America/Chicago:20240101T090000".
+func (r *RRule) formatDate(t time.Time, dateOnly bool) string {
+	t = t.In(r.location())
+
+	if dateOnly {
+		return ";VALUE=DATE:" + t.Format("20060102")
+	}
+	if r.floating {
+		return ":" + t.Format("20060102T150405")
+	}
+	if zone := t.Location().String(); t.Location() != time.UTC && zone != "" {
+		if _, err := time.LoadLocation(zone); err == nil {
+			return ";TZID=" + zone + ":" + t.Format("20060102T150405")
+		}
+	}
+	return ":" + t.UTC().Format("20060102T150405Z")
+}
+
+// formatUntil formats the UNTIL value, which RFC 5545 requires to be in UTC unless the DTSTART is a floating time.
+func (r *RRule) formatUntil() string {
+	until := r.until.In(r.location())
+
+	if r.untilDateOnly {
+		return until.Format("20060102")
+	}
+	if r.floating {
+		return until.Format("20060102T150405")
+	}
+	return until.UTC().Format("20060102T150405Z")
+}
+
+// rruleFilter holds the rule parts used to expand a rule, with the defaults that come from the DTSTART filled in.
+type rruleFilter struct {
+	freq       rruleFrequency
+	wkst       time.Weekday
+	bySecond   []int
+	byMinute   []int
+	byHour     []int
+	byDay      []rruleWeekday
+	byMonthDay []int
+	byYearDay  []int
+	byWeekNo   []int
+	byMonth    []int
+	bySetPos   []int
+}
+
+func (r *RRule) filter() *rruleFilter {
+	start := r.dtstart.ToTime()
+
+	f := &rruleFilter{
+		freq:       r.freq,
+		wkst:       r.wkst,
+		bySecond:   r.bySecond,
+		byMinute:   r.byMinute,
+		byHour:     r.byHour,
+		byDay:      r.byDay,
+		byMonthDay: r.byMonthDay,
+		byYearDay:  r.byYearDay,
+		byWeekNo:   r.byWeekNo,
+		byMonth:    r.byMonth,
+		bySetPos:   r.bySetPos,
+	}
+
+	// Without any day parts, the rule repeats on the day of the DTSTART.
+	if len(f.byWeekNo)+len(f.byYearDay)+len(f.byMonthDay)+len(f.byDay) == 0 {
+		switch f.freq {
+		case yearly:
+			if len(f.byMonth) == 0 {
+				f.byMonth = []int{int(start.Month())}
+			}
+			f.byMonthDay = []int{start.Day()}
+		case monthly:
+			f.byMonthDay = []int{start.Day()}
+		case weekly:
+			f.byDay = []rruleWeekday{{weekday: start.Weekday()}}
+		}
+	}
+
+	// Time parts smaller than the frequency default to the time of the DTSTART.
+	if len(f.byHour) == 0 && f.freq < hourly {
+		f.byHour = []int{start.Hour()}
+	}
+	if len(f.byMinute) == 0 && f.freq < minutely {
+		f.byMinute = []int{start.Minute()}
+	}
+	if len(f.bySecond) == 0 && f.freq < secondly {
+		f.bySecond = []int{start.Second()}
+	}
+
+	return f
+}
+
+// matchesDate checks if a date, as midnight UTC, matches the day parts of the rule.
+func (f *rruleFilter) matchesDate(date time.Time) bool {
+	year, month, day := date.Date()
+
+	if len(f.byMonth) > 0 && !containsInt(f.byMonth, int(month)) {
+		return false
+	}
+	if len(f.byWeekNo) > 0 {
+		week, weekYear := rruleWeek(date, f.wkst)
+		if !matchesSignedInt(f.byWeekNo, week, rruleWeeksInYear(weekYear, f.wkst)) {
+			return false
+		}
+	}
+	if len(f.byYearDay) > 0 && !matchesSignedInt(f.byYearDay, date.YearDay(), daysInYear(year)) {
+		return false
+	}
+	if len(f.byMonthDay) > 0 && !matchesSignedInt(f.byMonthDay, day, daysInMonth(int(month), year)) {
+		return false
+	}
+	if len(f.byDay) > 0 && !f.matchesWeekday(date) {
+		return false
+	}
+	return true
+}
+
+func (f *rruleFilter) matchesWeekday(date time.Time) bool {
+	year, month, day := date.Date()
+
+	// Ordinals count within the month for MONTHLY rules & YEARLY rules with BYMONTH, otherwise within the year.
+	index, total := date.YearDay(), daysInYear(year)
+	if f.freq == monthly || len(f.byMonth) > 0 {
+		index, total = day, daysInMonth(int(month), year)
+	}
+
+	for _, wd := range f.byDay {
+		if wd.weekday != date.Weekday() {
+			continue
+		}
+		if wd.n == 0 || (wd.n > 0 && (index-1)/7+1 == wd.n) || (wd.n < 0 && (total-index)/7+1 == -wd.n) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *rruleFilter) matchesTime(t time.Time) bool {
+	return (len(f.byHour) == 0 || containsInt(f.byHour, t.Hour())) &&
+		(len(f.byMinute) == 0 || containsInt(f.byMinute, t.Minute())) &&
+		(len(f.bySecond) == 0 || containsInt(f.bySecond, t.Second()))
+}
+
+// applySetPos returns the occurrences of a period picked by BYSETPOS, or all of them if there is no BYSETPOS.
+func (f *rruleFilter) applySetPos(times []time.Time) []time.Time {
+	if len(f.bySetPos) == 0 {
+		return times
+	}
+
+	picked := []time.Time{}
+	for _, pos := range f.bySetPos {
+		i := pos - 1
+		if pos < 0 {
+			i = len(times) + pos
+		}
+		if i >= 0 && i < len(times) {
+			picked = append(picked, times[i])
+		}
+	}
+
+	return uniqueTimes(sortTimes(picked))
+}
+
+// rruleIterator expands a rule one period of the frequency at a time.
+type rruleIterator struct {
+	rule         *RRule
+	filter       *rruleFilter
+	period       int
+	buffer       []time.Time
+	emitted      int
+	startEmitted bool
+	done         bool
+	// lastYear is the year of the last period with an occurrence.
+	lastYear int
+}
+
+func newRRuleIterator(r *RRule) *rruleIterator {
+	return &rruleIterator{rule: r, filter: r.filter(), lastYear: r.dtstart.ToTime().Year()}
+}
+
+// next returns the next occurrence of the rule. The DTSTART is always the first occurrence, as RFC 5545 defines,
+// even when it does not match the rule.
+func (it *rruleIterator) next() (time.Time, bool) {
+	r := it.rule
+	start := r.dtstart.ToTime()
+
+	for !it.done {
+		if r.count > 0 && it.emitted >= r.count {
+			break
+		}
+
+		var t time.Time
+		if !it.startEmitted {
+			it.startEmitted = true
+			t = start
+		} else if len(it.buffer) > 0 {
+			t, it.buffer = it.buffer[0], it.buffer[1:]
+			if !t.After(start) {
+				continue
+			}
+		} else {
+			it.done = !it.fill()
+			continue
+		}
+
+		if !r.until.IsZero() && t.After(r.until) {
+			break
+		}
+
+		it.emitted++
+		return t, true
+	}
+
+	it.done = true
+	return time.Time{}, false
+}
+
+// fill expands the next period into the buffer, returning false once the periods are past the UNTIL or the last
+// supported year, or there has been no occurrence for rruleSearchYears.
+func (it *rruleIterator) fill() bool {
+	r := it.rule
+	f := it.filter
+	loc := r.location()
+
+	if r.freq >= hourly {
+		start := it.subDailyPeriodStart()
+		if !it.inSearch(start.Year()) || (!r.until.IsZero() && start.After(r.until)) {
+			return false
+		}
+
+		// Skip whole days or hours that the rule excludes, rather than stepping through each period in them.
+		y, m, d := start.Date()
+		if !f.matchesDate(time.Date(y, m, d, 0, 0, 0, 0, time.UTC)) {
+			it.skipTo(start, time.Date(y, m, d+1, 0, 0, 0, 0, loc))
+			return true
+		}
+		if r.freq > hourly && len(f.byHour) > 0 && !containsInt(f.byHour, start.Hour()) {
+			it.skipTo(start, start.Add(time.Hour-time.Duration(start.Minute())*time.Minute-time.Duration(start.Second())*time.Second))
+			return true
+		}
+
+		times := []time.Time{}
+		for _, t := range it.subDailyCandidates(start) {
+			if f.matchesTime(t) {
+				times = append(times, t)
+			}
+		}
+		it.buffer = f.applySetPos(uniqueTimes(sortTimes(times)))
+		it.found(start.Year())
+		return true
+	}
+
+	days := it.periodDays()
+	if len(days) == 0 {
+		it.period++
+		return true
+	}
+
+	first := days[0]
+	if !it.inSearch(first.Year()) {
+		return false
+	}
+	if !r.until.IsZero() && time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, loc).After(r.until) {
+		return false
+	}
+
+	nanosecond := r.dtstart.ToTime().Nanosecond()
+	times := []time.Time{}
+	for _, day := range days {
+		if !f.matchesDate(day) {
+			continue
+		}
+		for _, hour := range f.byHour {
+			for _, minute := range f.byMinute {
+				for _, second := range f.bySecond {
+					times = append(times, wallClockTime(day.Year(), day.Month(), day.Day(), hour, minute, second, nanosecond, loc))
+				}
+			}
+		}
+	}
+
+	it.buffer = f.applySetPos(uniqueTimes(sortTimes(times)))
+	it.found(first.Year())
+	return true
+}
+
+// inSearch checks if a period in a year should be expanded, which it is until the last supported year or
+// rruleSearchYears after the last occurrence.
+func (it *rruleIterator) inSearch(year int) bool {
+	return year <= maxRRuleYear && year <= it.lastYear+rruleSearchYears
+}
+
+// found moves the iterator to the next period, after the period in a year filled the buffer.
+func (it *rruleIterator) found(year int) {
+	if len(it.buffer) > 0 {
+		it.lastYear = year
+	}
+	it.period++
+}
+
+// periodDays returns the days in the current period of a YEARLY, MONTHLY, WEEKLY or DAILY rule, as midnight UTC.
+func (it *rruleIterator) periodDays() []time.Time {
+	r := it.rule
+	start := r.dtstart.ToTime()
+	step := it.period * r.interval
+	date := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+
+	var first, last time.Time
+	switch r.freq {
+	case yearly:
+		year := start.Year() + step
+		first = time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+		last = time.Date(year, 12, 31, 0, 0, 0, 0, time.UTC)
+		if len(r.byWeekNo) > 0 {
+			// The weeks of a year can start in the previous year & end in the next.
+			first, last = first.AddDate(0, 0, -6), last.AddDate(0, 0, 6)
+		}
+	case monthly:
+		first = time.Date(start.Year(), start.Month()+time.Month(step), 1, 0, 0, 0, 0, time.UTC)
+		last = first.AddDate(0, 1, -1)
+	case weekly:
+		first = date.AddDate(0, 0, 7*step-(7+int(start.Weekday())-int(r.wkst))%7)
+		last = first.AddDate(0, 0, 6)
+	default:
+		first = date.AddDate(0, 0, step)
+		last = first
+	}
+
+	days := []time.Time{}
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		if r.freq == yearly && len(r.byWeekNo) > 0 {
+			if _, weekYear := rruleWeek(day, r.wkst); weekYear != start.Year()+step {
+				continue
+			}
+		}
+		days = append(days, day)
+	}
+
+	return days
+}
+
+// rruleWeek returns the week & week-year of a date, as midnight UTC, with weeks starting on the WKST. Like ISO weeks,
+// the first week of a year is the one with at least four days in the year.
+func rruleWeek(date time.Time, wkst time.Weekday) (int, int) {
+	fourthDay := date.AddDate(0, 0, 3-(7+int(date.Weekday())-int(wkst))%7)
+	return (fourthDay.YearDay()-1)/7 + 1, fourthDay.Year()
+}
+
+// rruleWeeksInYear returns the number of weeks in a week-year, with weeks starting on the WKST.
+func rruleWeeksInYear(year int, wkst time.Weekday) int {
+	week, weekYear := rruleWeek(time.Date(year, 12, 31, 0, 0, 0, 0, time.UTC), wkst)
+	if weekYear != year {
+		week, _ = rruleWeek(time.Date(year, 12, 24, 0, 0, 0, 0, time.UTC), wkst)
+	}
+	return week
+}
+
+func (it *rruleIterator) subDailyStep() time.Duration {
+	unit := time.Second
+	switch it.rule.freq {
+	case hourly:
+		unit = time.Hour
+	case minutely:
+		unit = time.Minute
+	}
+	return time.Duration(it.rule.interval) * unit
+}
+
+// subDailyPeriodStart returns the start of the current period of an HOURLY, MINUTELY or SECONDLY rule.
+func (it *rruleIterator) subDailyPeriodStart() time.Time {
+	start := it.rule.dtstart.ToTime()
+
+	switch it.rule.freq {
+	case hourly:
+		start = start.Add(-time.Duration(start.Minute())*time.Minute - time.Duration(start.Second())*time.Second)
+	case minutely:
+		start = start.Add(-time.Duration(start.Second()) * time.Second)
+	}
+
+	// Counting in seconds keeps periods more than 292 years from the start from overflowing a time.Duration.
+	seconds := int64(it.period) * int64(it.subDailyStep()/time.Second)
+	return time.Unix(start.Unix()+seconds, int64(start.Nanosecond())).In(start.Location())
+}
+
+// subDailyCandidates returns the times in a period of an HOURLY or MINUTELY rule, which BYMINUTE & BYSECOND expand.
+func (it *rruleIterator) subDailyCandidates(start time.Time) []time.Time {
+	f := it.filter
+
+	switch it.rule.freq {
+	case hourly:
+		times := []time.Time{}
+		for _, minute := range f.byMinute {
+			for _, second := range f.bySecond {
+				times = append(times, start.Add(time.Duration(minute)*time.Minute+time.Duration(second)*time.Second))
+			}
+		}
+		return times
+	case minutely:
+		times := []time.Time{}
+		for _, second := range f.bySecond {
+			times = append(times, start.Add(time.Duration(second)*time.Second))
+		}
+		return times
+	}
+
+	return []time.Time{start}
+}
+
+// skipBefore moves the iterator to the period before the one that contains a time, so the occurrences of the periods
+// before it are not expanded. The search for an occurrence then starts from the year of the time. A rule with a COUNT
+// can't skip periods, since it has to count the occurrences in them.
+func (it *rruleIterator) skipBefore(t time.Time) {
+	r := it.rule
+	start := r.dtstart.ToTime()
+	if !t.After(start) {
+		return
+	}
+	t = t.In(r.location())
+
+	var period int
+	switch r.freq {
+	case yearly:
+		period = (t.Year() - start.Year()) / r.interval
+	case monthly:
+		period = ((t.Year()-start.Year())*12 + int(t.Month()) - int(start.Month())) / r.interval
+	case weekly:
+		weekStart := civilDay(start) - (7+int(start.Weekday())-int(r.wkst))%7
+		period = (civilDay(t) - weekStart) / (7 * r.interval)
+	case daily:
+		period = (civilDay(t) - civilDay(start)) / r.interval
+	default:
+		period = int((t.Unix() - it.subDailyPeriodStart().Unix()) / int64(it.subDailyStep()/time.Second))
+	}
+
+	// The period before is expanded too, as its occurrences can spill into the next period, like the weeks of a year.
+	if period > 1 {
+		it.period = period - 1
+		it.startEmitted = true
+		it.lastYear = t.Year()
+	}
+}
+
+// skipTo moves the iterator to the first period that starts at or after a time.
+func (it *rruleIterator) skipTo(start, to time.Time) {
+	step := it.subDailyStep()
+	periods := int((to.Sub(start) + step - 1) / step)
+	if periods < 1 {
+		periods = 1
+	}
+	it.period += periods
+}
+
+// rruleProperty is a line of an iCalendar recurrence string, like "EXDATE;TZID=America/Chicago:20240101T090000".
+type rruleProperty struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+func splitRRuleProperties(rule string) ([]rruleProperty, error) {
+	// Lines that start with whitespace continue the previous line.
+	rule = strings.NewReplacer("\r\n ", "", "\r\n\t", "", "\n ", "", "\n\t", "").Replace(rule)
+
+	properties := []rruleProperty{}
+	for _, line := range strings.Split(rule, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		colon := strings.Index(line, ":")
+		if colon < 0 {
+			// A line without a property name is the rule itself.
+			properties = append(properties, rruleProperty{name: "RRULE", value: line})
+			continue
+		}
+
+		nameParams := strings.Split(line[:colon], ";")
+		p := rruleProperty{name: strings.ToUpper(nameParams[0]), params: map[string]string{}, value: line[colon+1:]}
+		for _, param := range nameParams[1:] {
+			keyValue := strings.SplitN(param, "=", 2)
+			if len(keyValue) != 2 {
+				return nil, errors.New("Invalid recurrence property parameter " + param)
+			}
+			p.params[strings.ToUpper(keyValue[0])] = keyValue[1]
+		}
+
+		switch p.name {
+		case "DTSTART", "RRULE", "RDATE", "EXDATE":
+			properties = append(properties, p)
+		default:
+			return nil, errors.New("Unsupported recurrence property " + p.name)
+		}
+	}
+
+	return properties, nil
+}
+
+// parseRRuleDates parses the comma separated dates of a DTSTART, RDATE or EXDATE property. Floating times use the
+// location passed, unless the property has a TZID.
+func parseRRuleDates(p rruleProperty, loc *time.Location) ([]rruleDate, error) {
+	if tzid, ok := p.params["TZID"]; ok {
+		var err error
+		if loc, err = time.LoadLocation(tzid); err != nil {
+			return nil, errors.New("Invalid time zone " + tzid)
+		}
+	}
+
+	dates := []rruleDate{}
+	for _, value := range strings.Split(p.value, ",") {
+		d, err := parseRRuleDate(value, loc)
+		if err != nil {
+			return nil, err
+		}
+		dates = append(dates, d)
+	}
+
+	return dates, nil
+}
+
+// parseRRuleDate parses a date like 20240101, a floating time like 20240101T090000 or a UTC time like
+// 20240101T090000Z.
+func parseRRuleDate(value string, loc *time.Location) (rruleDate, error) {
+	value = strings.TrimSpace(value)
+
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return rruleDate{time: t}, nil
+	}
+	if t, err := time.ParseInLocation("20060102T150405", value, loc); err == nil {
+		return rruleDate{time: t}, nil
+	}
+	if t, err := time.ParseInLocation("20060102", value, loc); err == nil {
+		return rruleDate{time: t, dateOnly: true}, nil
+	}
+
+	return rruleDate{}, errors.New("Invalid recurrence date " + value)
+}
+
+// parseRRuleInt parses a rule part value from min up, or up to max if max is not 0.
+func parseRRuleInt(name, value string, min, max int) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < min || (max != 0 && n > max) {
+		return 0, errors.New("Invalid " + name + " value " + value)
+	}
+	return n, nil
+}
+
+// parseRRuleInts parses a comma separated list of rule part values. Signed values can also be from -max to -min.
+func parseRRuleInts(name, value string, min, max int, signed bool) ([]int, error) {
+	values := []int{}
+	for _, v := range strings.Split(value, ",") {
+		abs := strings.TrimPrefix(strings.TrimPrefix(v, "+"), "-")
+		if signed && abs != v && strings.HasPrefix(v, "-") {
+			n, err := parseRRuleInt(name, abs, min, max)
+			if err != nil {
+				return nil, errors.New("Invalid " + name + " value " + v)
+			}
+			values = append(values, -n)
+			continue
+		}
+
+		n, err := parseRRuleInt(name, abs, min, max)
+		if err != nil || (!signed && abs != v) {
+			return nil, errors.New("Invalid " + name + " value " + v)
+		}
+		values = append(values, n)
+	}
+	return values, nil
+}
+
+func parseRRuleWeekdays(value string) ([]rruleWeekday, error) {
+	weekdays := []rruleWeekday{}
+	for _, v := range strings.Split(value, ",") {
+		if len(v) < 2 {
+			return nil, errors.New("Invalid BYDAY value " + v)
+		}
+
+		weekday, ok := rruleWeekdays[v[len(v)-2:]]
+		if !ok {
+			return nil, errors.New("Invalid BYDAY value " + v)
+		}
+
+		n := 0
+		if ordinal := v[:len(v)-2]; ordinal != "" {
+			ns, err := parseRRuleInts("BYDAY", ordinal, 1, 53, true)
+			if err != nil {
+				return nil, errors.New("Invalid BYDAY value " + v)
+			}
+			n = ns[0]
+		}
+
+		weekdays = append(weekdays, rruleWeekday{weekday: weekday, n: n})
+	}
+	return weekdays, nil
+}
+
+func formatRRuleInts(values []int) []string {
+	formatted := make([]string, len(values))
+	for i, v := range values {
+		formatted[i] = strconv.Itoa(v)
+	}
+	return formatted
+}
+
+func matchesSignedInt(values []int, value, total int) bool {
+	for _, v := range values {
+		if v == value || v == value-total-1 {
+			return true
+		}
+	}
+	return false
+}
+
+func containsInt(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func sortTimes(times []time.Time) []time.Time {
+	sort.Slice(times, func(i, j int) bool {
+		return times[i].Before(times[j])
+	})
+	return times
+}
+
+// uniqueTimes removes repeated times from sorted times.
+func uniqueTimes(times []time.Time) []time.Time {
+	unique := []time.Time{}
+	for i, t := range times {
+		if i == 0 || !t.Equal(times[i-1]) {
+			unique = append(unique, t)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,269 @@
+package goment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func simpleRRule(rule string, dtstart ...interface{}) *RRule {
+	r, _ := NewRRule(rule, dtstart...)
+	return r
+}
+
+func formatOccurrences(goments []*Goment) []string {
+	formatted := []string{}
+	for _, g := range goments {
+		formatted = append(formatted, g.ToTime().Format(time.RFC3339))
+	}
+	return formatted
+}
+
+func TestNewRRule(t *testing.T) {
+	assert := assert.New(t)
+
+	r, err := NewRRule("DTSTART;TZID=America/Chicago:20240101T090000\nRRULE:FREQ=DAILY;COUNT=2")
+	assert.Nil(err)
+	assert.Equal("2024-01-01T09:00:00-06:00", r.DTStart().ToTime().Format(time.RFC3339))
+	assert.Equal("America/Chicago", r.DTStart().TimeZoneName())
+
+	r, err = NewRRule("RRULE:FREQ=DAILY;COUNT=2", "2024-01-01T09:00:00Z")
+	assert.Nil(err)
+	assert.Equal([]string{"2024-01-01T09:00:00Z", "2024-01-02T09:00:00Z"}, formatOccurrences(r.All()))
+
+	r, err = NewRRule("freq=daily;count=2", time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC))
+	assert.Nil(err)
+	assert.Equal(2, len(r.All()))
+
+	_, err = NewRRule("FREQ=DAILY")
+	assert.EqualError(err, "Recurrence rule requires a DTSTART")
+
+	errors := map[string]string{
+		"COUNT=2":                                         "Recurrence rule requires a FREQ",
+		"FREQ=FORTNIGHTLY":                                "Invalid FREQ value FORTNIGHTLY",
+		"FREQ=DAILY;FOO=BAR":                              "Invalid recurrence rule part FOO=BAR",
+		"FREQ=DAILY;COUNT":                                "Invalid recurrence rule part COUNT",
+		"FREQ=DAILY;INTERVAL=0":                           "Invalid INTERVAL value 0",
+		"FREQ=MONTHLY;BYMONTHDAY=32":                      "Invalid BYMONTHDAY value 32",
+		"FREQ=MONTHLY;BYMONTHDAY=0":                       "Invalid BYMONTHDAY value 0",
+		"FREQ=YEARLY;BYMONTH=-1":                          "Invalid BYMONTH value -1",
+		"FREQ=MONTHLY;BYDAY=XX":                           "Invalid BYDAY value XX",
+		"FREQ=MONTHLY;BYDAY=0MO":                          "Invalid BYDAY value 0MO",
+		"FREQ=DAILY;WKST=XX":                              "Invalid WKST value XX",
+		"FREQ=DAILY;COUNT=2;UNTIL=20240110":               "Recurrence rule can not have both COUNT and UNTIL",
+		"FREQ=DAILY;UNTIL=tomorrow":                       "Invalid recurrence date TOMORROW",
+		"FREQ=MONTHLY;BYWEEKNO=1":                         "BYWEEKNO is only valid with a YEARLY frequency",
+		"FREQ=MONTHLY;BYYEARDAY=1":                        "BYYEARDAY is not valid with a MONTHLY, WEEKLY or DAILY frequency",
+		"FREQ=WEEKLY;BYMONTHDAY=1":                        "BYMONTHDAY is not valid with a WEEKLY frequency",
+		"FREQ=WEEKLY;BYDAY=1MO":                           "BYDAY ordinals are only valid with a MONTHLY or YEARLY frequency",
+		"FREQ=MONTHLY;BYSETPOS=1":                         "BYSETPOS must be used with another BY rule part",
+		"FREQ=DAILY\nFREQ=WEEKLY":                         "Recurrence rule can only have one RRULE",
+		"FREQ=DAILY\nSUMMARY:Meeting":                     "Unsupported recurrence property SUMMARY",
+		"FREQ=DAILY\nEXDATE;TZID=Nowhere:20240101T090000": "Invalid time zone Nowhere",
+	}
+	for rule, message := range errors {
+		_, err = NewRRule(rule, "2024-01-01T09:00:00Z")
+		assert.EqualError(err, message, rule)
+	}
+}
+
+func TestRRuleString(t *testing.T) {
+	assert := assert.New(t)
+
+	rule := "DTSTART;TZID=America/Chicago:20240101T090000\n" +
+		"RRULE:FREQ=MONTHLY;INTERVAL=2;WKST=SU;UNTIL=20241231T150000Z;BYSETPOS=-1;BYDAY=MO,TU,WE,TH,FR\n" +
+		"RDATE;TZID=America/Chicago:20240215T090000\n" +
+		"EXDATE;TZID=America/Chicago:20240131T090000"
+	r := simpleRRule(rule)
+	assert.Equal(rule, r.String())
+	assert.Equal(rule, simpleRRule(r.String()).String())
+
+	r = simpleRRule("FREQ=YEARLY;BYMONTH=11;BYDAY=+4TH;COUNT=3", "2024-11-28T12:00:00Z")
+	assert.Equal("DTSTART:20241128T120000Z\nRRULE:FREQ=YEARLY;COUNT=3;BYMONTH=11;BYDAY=4TH", r.String())
+
+	r = simpleRRule("DTSTART;VALUE=DATE:20240101\nRRULE:FREQ=WEEKLY;UNTIL=20240201\nEXDATE;VALUE=DATE:20240108")
+	assert.Equal("DTSTART;VALUE=DATE:20240101\nRRULE:FREQ=WEEKLY;UNTIL=20240201\nEXDATE;VALUE=DATE:20240108", r.String())
+
+	r = simpleRRule("DTSTART:20240101T090000\nRRULE:FREQ=DAILY;UNTIL=20240105T090000")
+	assert.Equal("DTSTART:20240101T090000\nRRULE:FREQ=DAILY;UNTIL=20240105T090000", r.String())
+	assert.Equal(time.Local, r.DTStart().ToTime().Location())
+
+	r = simpleRRule("FREQ=DAILY;COUNT=3", "2024-01-01T09:00:00Z")
+	r.AddRDate("2024-01-10T09:00:00Z")
+	r.AddExDate("2024-01-02T09:00:00Z")
+	assert.Equal("DTSTART:20240101T090000Z\nRRULE:FREQ=DAILY;COUNT=3\nRDATE:20240110T090000Z\nEXDATE:20240102T090000Z", r.String())
+}
+
+func TestRRuleFrequencies(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		rule     string
+		expected []string
+	}{
+		{"FREQ=YEARLY;COUNT=3", []string{"2024-02-29T09:00:00Z", "2028-02-29T09:00:00Z", "2032-02-29T09:00:00Z"}},
+		{"FREQ=MONTHLY;COUNT=3", []string{"2024-02-29T09:00:00Z", "2024-03-29T09:00:00Z", "2024-04-29T09:00:00Z"}},
+		{"FREQ=WEEKLY;INTERVAL=2;COUNT=3", []string{"2024-02-29T09:00:00Z", "2024-03-14T09:00:00Z", "2024-03-28T09:00:00Z"}},
+		{"FREQ=DAILY;INTERVAL=10;COUNT=3", []string{"2024-02-29T09:00:00Z", "2024-03-10T09:00:00Z", "2024-03-20T09:00:00Z"}},
+		{"FREQ=HOURLY;INTERVAL=8;COUNT=3", []string{"2024-02-29T09:00:00Z", "2024-02-29T17:00:00Z", "2024-03-01T01:00:00Z"}},
+		{"FREQ=MINUTELY;INTERVAL=90;COUNT=3", []string{"2024-02-29T09:00:00Z", "2024-02-29T10:30:00Z", "2024-02-29T12:00:00Z"}},
+		{"FREQ=SECONDLY;INTERVAL=30;COUNT=3", []string{"2024-02-29T09:00:00Z", "2024-02-29T09:00:30Z", "2024-02-29T09:01:00Z"}},
+		{"FREQ=HOURLY;BYMINUTE=0,30;COUNT=3", []string{"2024-02-29T09:00:00Z", "2024-02-29T09:30:00Z", "2024-02-29T10:00:00Z"}},
+		{"FREQ=DAILY;BYHOUR=9,17;COUNT=3", []string{"2024-02-29T09:00:00Z", "2024-02-29T17:00:00Z", "2024-03-01T09:00:00Z"}},
+		{"FREQ=MINUTELY;INTERVAL=20;BYHOUR=9;COUNT=4", []string{"2024-02-29T09:00:00Z", "2024-02-29T09:20:00Z", "2024-02-29T09:40:00Z", "2024-03-01T09:00:00Z"}},
+		{"FREQ=DAILY;UNTIL=20240302T090000Z", []string{"2024-02-29T09:00:00Z", "2024-03-01T09:00:00Z", "2024-03-02T09:00:00Z"}},
+		{"FREQ=DAILY;UNTIL=20240301", []string{"2024-02-29T09:00:00Z", "2024-03-01T09:00:00Z"}},
+	}
+
+	for _, test := range tests {
+		r, err := NewRRule(test.rule, "2024-02-29T09:00:00Z")
+		assert.Nil(err, test.rule)
+		assert.Equal(test.expected, formatOccurrences(r.All()), test.rule)
+	}
+}
+
+func TestRRuleByParts(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		rule     string
+		expected []string
+	}{
+		// Last Friday of the month.
+		{"DTSTART:20240126T090000Z\nRRULE:FREQ=MONTHLY;BYDAY=-1FR;COUNT=4", []string{"2024-01-26", "2024-02-23", "2024-03-29", "2024-04-26"}},
+		// The DTSTART is always the first occurrence.
+		{"DTSTART:20240101T090000Z\nRRULE:FREQ=MONTHLY;BYDAY=-1FR;COUNT=2", []string{"2024-01-01", "2024-01-26"}},
+		// Last working day of the month.
+		{"DTSTART:20240131T090000Z\nRRULE:FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1;COUNT=4", []string{"2024-01-31", "2024-02-29", "2024-03-29", "2024-04-30"}},
+		// Last day of the month.
+		{"DTSTART:20240131T090000Z\nRRULE:FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=3", []string{"2024-01-31", "2024-02-29", "2024-03-31"}},
+		// Months without the day are skipped.
+		{"DTSTART:20240131T090000Z\nRRULE:FREQ=MONTHLY;COUNT=3", []string{"2024-01-31", "2024-03-31", "2024-05-31"}},
+		// Thanksgiving.
+		{"DTSTART:20241128T090000Z\nRRULE:FREQ=YEARLY;BYMONTH=11;BYDAY=4TH;COUNT=3", []string{"2024-11-28", "2025-11-27", "2026-11-26"}},
+		// Friday the 13th.
+		{"DTSTART:20240101T090000Z\nRRULE:FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13;COUNT=3", []string{"2024-01-01", "2024-09-13", "2024-12-13"}},
+		// The 20th Monday of the year.
+		{"DTSTART:19970519T090000Z\nRRULE:FREQ=YEARLY;BYDAY=20MO;COUNT=3", []string{"1997-05-19", "1998-05-18", "1999-05-17"}},
+		// Monday of week 20.
+		{"DTSTART:19970512T090000Z\nRRULE:FREQ=YEARLY;BYWEEKNO=20;BYDAY=MO;COUNT=3", []string{"1997-05-12", "1998-05-11", "1999-05-17"}},
+		// Week 1 can start in the previous year.
+		{"DTSTART:20241230T090000Z\nRRULE:FREQ=YEARLY;BYWEEKNO=1;BYDAY=MO;COUNT=3", []string{"2024-12-30", "2025-12-29", "2027-01-04"}},
+		{"DTSTART:19970101T090000Z\nRRULE:FREQ=YEARLY;INTERVAL=3;BYYEARDAY=1,100,200;COUNT=6", []string{"1997-01-01", "1997-04-10", "1997-07-19", "2000-01-01", "2000-04-09", "2000-07-18"}},
+		{"DTSTART:19970805T090000Z\nRRULE:FREQ=WEEKLY;INTERVAL=2;COUNT=4;BYDAY=TU,SU;WKST=MO", []string{"1997-08-05", "1997-08-10", "1997-08-19", "1997-08-24"}},
+		{"DTSTART:19970805T090000Z\nRRULE:FREQ=WEEKLY;INTERVAL=2;COUNT=4;BYDAY=TU,SU;WKST=SU", []string{"1997-08-05", "1997-08-17", "1997-08-19", "1997-08-31"}},
+		{"DTSTART:20240101T090000Z\nRRULE:FREQ=DAILY;BYMONTH=1,3;BYDAY=SU;UNTIL=20240331", []string{"2024-01-01", "2024-01-07", "2024-01-14", "2024-01-21", "2024-01-28", "2024-03-03", "2024-03-10", "2024-03-17", "2024-03-24", "2024-03-31"}},
+	}
+
+	for _, test := range tests {
+		r, err := NewRRule(test.rule)
+		assert.Nil(err, test.rule)
+
+		dates := []string{}
+		for _, g := range r.All() {
+			dates = append(dates, g.Format("YYYY-MM-DD"))
+		}
+		assert.Equal(test.expected, dates, test.rule)
+	}
+}
+
+func TestRRuleAcrossDST(t *testing.T) {
+	assert := assert.New(t)
+
+	r := simpleRRule("DTSTART;TZID=America/Chicago:20240309T090000\nRRULE:FREQ=DAILY;COUNT=3")
+	assert.Equal([]string{"2024-03-09T09:00:00-06:00", "2024-03-10T09:00:00-05:00", "2024-03-11T09:00:00-05:00"}, formatOccurrences(r.All()))
+
+	// A time in the DST gap uses the offset from before the gap.
+	r = simpleRRule("DTSTART;TZID=America/Chicago:20240309T023000\nRRULE:FREQ=DAILY;COUNT=3")
+	assert.Equal([]string{"2024-03-09T02:30:00-06:00", "2024-03-10T03:30:00-05:00", "2024-03-11T02:30:00-05:00"}, formatOccurrences(r.All()))
+
+	// A repeated time uses the first occurrence, as RFC 5545 defines, in every time zone.
+	r = simpleRRule("DTSTART;TZID=America/Chicago:20241102T013000\nRRULE:FREQ=DAILY;COUNT=3")
+	assert.Equal([]string{"2024-11-02T01:30:00-05:00", "2024-11-03T01:30:00-05:00", "2024-11-04T01:30:00-06:00"}, formatOccurrences(r.All()))
+
+	r = simpleRRule("DTSTART;TZID=Europe/London:20241026T013000\nRRULE:FREQ=DAILY;COUNT=3")
+	assert.Equal([]string{"2024-10-26T01:30:00+01:00", "2024-10-27T01:30:00+01:00", "2024-10-28T01:30:00Z"}, formatOccurrences(r.All()))
+
+	r = simpleRRule("DTSTART;TZID=Australia/Sydney:20240406T023000\nRRULE:FREQ=DAILY;COUNT=3")
+	assert.Equal([]string{"2024-04-06T02:30:00+11:00", "2024-04-07T02:30:00+11:00", "2024-04-08T02:30:00+10:00"}, formatOccurrences(r.All()))
+
+	r = simpleRRule("DTSTART;TZID=Australia/Sydney:20241005T023000\nRRULE:FREQ=DAILY;COUNT=3")
+	assert.Equal([]string{"2024-10-05T02:30:00+10:00", "2024-10-06T03:30:00+11:00", "2024-10-07T02:30:00+11:00"}, formatOccurrences(r.All()))
+
+	// Hourly rules step in elapsed time.
+	r = simpleRRule("DTSTART;TZID=America/Chicago:20240310T000000\nRRULE:FREQ=HOURLY;COUNT=4")
+	assert.Equal([]string{"2024-03-10T00:00:00-06:00", "2024-03-10T01:00:00-06:00", "2024-03-10T03:00:00-05:00", "2024-03-10T04:00:00-05:00"}, formatOccurrences(r.All()))
+
+	r = simpleRRule("FREQ=WEEKLY;BYDAY=SU;COUNT=2", simpleTime(time.Date(2024, 11, 3, 0, 0, 0, 0, chicagoLocation())).Add(-1, "weeks"))
+	for _, g := range r.All() {
+		assert.Equal(0, g.Hour())
+		assert.Equal("America/Chicago", g.TimeZoneName())
+	}
+}
+
+func TestRRuleDates(t *testing.T) {
+	assert := assert.New(t)
+
+	r := simpleRRule("DTSTART:20240101T090000Z\nRRULE:FREQ=DAILY;COUNT=4\nEXDATE:20240102T090000Z,20240103T090000Z\nRDATE:20240110T090000Z\nRDATE:20240101T090000Z")
+	assert.Equal([]string{"2024-01-01T09:00:00Z", "2024-01-04T09:00:00Z", "2024-01-10T09:00:00Z"}, formatOccurrences(r.All()))
+
+	r = simpleRRule("DTSTART:20240101T090000Z\nRRULE:FREQ=DAILY;COUNT=3\nEXDATE;VALUE=DATE:20240102")
+	assert.Equal([]string{"2024-01-01T09:00:00Z", "2024-01-03T09:00:00Z"}, formatOccurrences(r.All()))
+
+	r = simpleRRule("FREQ=WEEKLY;COUNT=3", "2024-01-01T09:00:00Z")
+	assert.Nil(r.AddExDate("2024-01-08T09:00:00Z"))
+	assert.Nil(r.AddRDate(time.Date(2024, 1, 9, 9, 0, 0, 0, time.UTC)))
+	assert.Equal([]string{"2024-01-01T09:00:00Z", "2024-01-09T09:00:00Z", "2024-01-15T09:00:00Z"}, formatOccurrences(r.All()))
+	assert.EqualError(r.AddRDate("not a date"), "Not a matching ISO-8601 date")
+}
+
+func TestRRuleBetween(t *testing.T) {
+	assert := assert.New(t)
+
+	r := simpleRRule("FREQ=WEEKLY;BYDAY=MO,FR", "2024-01-01T09:00:00Z")
+	assert.Nil(r.All())
+
+	assert.Equal([]string{"2024-01-05T09:00:00Z", "2024-01-08T09:00:00Z", "2024-01-12T09:00:00Z"}, formatOccurrences(r.Between("2024-01-05T09:00:00Z", "2024-01-12T09:00:00Z")))
+	assert.Equal([]string{"2024-01-08T09:00:00Z"}, formatOccurrences(r.Between("2024-01-05T09:00:00Z", "2024-01-12T09:00:00Z", "()")))
+	assert.Equal([]string{}, formatOccurrences(r.Between("2024-01-02", "2024-01-04")))
+	assert.Nil(r.Between("2024-01-12", "2024-01-05"))
+
+	assert.Equal("2024-01-05T09:00:00Z", r.After("2024-01-01T09:00:00Z", false).ToTime().Format(time.RFC3339))
+	assert.Equal("2024-01-01T09:00:00Z", r.After("2024-01-01T09:00:00Z", true).ToTime().Format(time.RFC3339))
+	assert.Equal("2024-01-01T09:00:00Z", r.Before("2024-01-05T09:00:00Z", false).ToTime().Format(time.RFC3339))
+	assert.Equal("2024-01-05T09:00:00Z", r.Before("2024-01-05T09:00:00Z", true).ToTime().Format(time.RFC3339))
+	assert.Nil(r.Before("2024-01-01T09:00:00Z", false))
+
+	r = simpleRRule("FREQ=DAILY;COUNT=2", "2024-01-01T09:00:00Z")
+	assert.Nil(r.After("2024-01-02T09:00:00Z", false))
+
+	// Rules that never match end after 100 years without an occurrence.
+	r = simpleRRule("FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30", "2024-01-01T09:00:00Z")
+	assert.Equal("2024-01-01T09:00:00Z", r.Before("3000-01-01", false).ToTime().Format(time.RFC3339))
+	assert.Nil(r.After("2024-01-01T09:00:00Z", false))
+
+	r = simpleRRule("FREQ=YEARLY;BYWEEKNO=53;BYMONTH=2;BYDAY=MO", "2024-01-01T09:00:00Z")
+	assert.Nil(r.After("2024-01-01T09:00:00Z", false))
+	assert.Equal([]string{"2024-01-01T09:00:00Z"}, formatOccurrences(r.Between("2024-01-01", "9999-01-01")))
+
+	r = simpleRRule("FREQ=HOURLY;BYMONTH=2;BYMONTHDAY=30", "2024-01-01T09:00:00Z")
+	assert.Nil(r.After("2024-01-01T09:00:00Z", false))
+
+	// Rare occurrences are still found.
+	r = simpleRRule("FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=29;BYDAY=MO", "2024-01-01T09:00:00Z")
+	assert.Equal("2044-02-29T09:00:00Z", r.After("2024-01-01T09:00:00Z", false).ToTime().Format(time.RFC3339))
+	assert.Equal("2072-02-29T09:00:00Z", r.After("2044-03-01", false).ToTime().Format(time.RFC3339))
+
+	// Rules without a COUNT start from the period of a far off bound, rather than expanding every period before it.
+	r = simpleRRule("FREQ=MINUTELY;INTERVAL=7", "2000-01-01T00:00:30Z")
+	assert.Equal("2400-01-01T00:00:30Z", r.After("2400-01-01", false).ToTime().Format(time.RFC3339))
+	assert.Equal([]string{"2024-01-01T00:04:30Z", "2024-01-01T00:11:30Z"}, formatOccurrences(r.Between("2024-01-01", "2024-01-01T00:15:00Z")))
+
+	r = simpleRRule("FREQ=WEEKLY;INTERVAL=2;BYDAY=TU;WKST=SU", "2000-01-01T09:00:00Z")
+	assert.Equal("9000-01-07T09:00:00Z", r.After("9000-01-01", false).ToTime().Format(time.RFC3339))
+
+	// A COUNT is still counted from the DTSTART.
+	r = simpleRRule("FREQ=MINUTELY;COUNT=3", "2000-01-01T00:00:00Z")
+	assert.Nil(r.After("2024-01-01", false))
+}
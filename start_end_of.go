@@ -2,25 +2,50 @@ package goment
 
 // StartOf mutates the original Goment by setting it to the start of a unit of time.
 func (g *Goment) StartOf(units string) *Goment {
-	switch units {
-	case "y", "year", "years":
+	return g.StartOfUnit(unitFromArg(units))
+}
+
+// StartOfE mutates the original Goment by setting it to the start of a unit of time, like StartOf. An error is
+// returned, and the Goment is unchanged, if the units are not supported.
+func (g *Goment) StartOfE(units string) (*Goment, error) {
+	unit, err := ParseUnit(units)
+	if err != nil {
+		return g, err
+	}
+	return g.StartOfUnit(unit), nil
+}
+
+// StartOfUnit mutates the original Goment by setting it to the start of the unit.
+func (g *Goment) StartOfUnit(unit Unit) *Goment {
+	switch unit {
+	case Year:
 		g.startOfYear()
-	case "Q", "quarter", "quarters":
+	case Quarter:
 		g.startOfQuarter()
-	case "M", "month", "months":
+	case Month:
 		g.startOfMonth()
-	case "w", "week", "weeks":
+	case Week:
 		g.startOfWeek()
-	case "W", "isoWeek", "isoWeeks":
+	case ISOWeek:
 		g.startOfISOWeek()
-	case "d", "day", "days", "date":
+	case Day:
 		g.startOfDay()
-	case "h", "hour", "hours":
+	case Hour:
 		g.startOfHour()
-	case "m", "minute", "minutes":
+	case Minute:
 		g.startOfMinute()
-	case "s", "second", "seconds":
+	case Second:
 		g.startOfSecond()
+	case Millisecond:
+		g.startOfMillisecond()
+	case JalaliYear:
+		g.startOfCalendarYear(jalali)
+	case JalaliMonth:
+		g.startOfCalendarMonth(jalali)
+	case HijriYear:
+		g.startOfCalendarYear(g.hijri)
+	case HijriMonth:
+		g.startOfCalendarMonth(g.hijri)
 	}
 	return g
 }
@@ -43,7 +68,7 @@ func (g *Goment) startOfWeek() *Goment {
 }
 
 func (g *Goment) startOfISOWeek() *Goment {
-	return g.SetDate(g.Date() - (g.ISOWeekday() - 1)).StartOf("day")
+	return g.SetDate(g.Date() - (g.ISOWeekday() - 1)).startOfDay()
 }
 
 func (g *Goment) startOfDay() *Goment {
@@ -62,27 +87,56 @@ func (g *Goment) startOfSecond() *Goment {
 	return g.SetNanosecond(0)
 }
 
+func (g *Goment) startOfMillisecond() *Goment {
+	return g.SetNanosecond(g.Nanosecond() / 1000000 * 1000000)
+}
+
 // EndOf mutates the original Goment by setting it to the end of a unit of time.
 func (g *Goment) EndOf(units string) *Goment {
-	switch units {
-	case "y", "year", "years":
+	return g.EndOfUnit(unitFromArg(units))
+}
+
+// EndOfE mutates the original Goment by setting it to the end of a unit of time, like EndOf. An error is returned,
+// and the Goment is unchanged, if the units are not supported.
+func (g *Goment) EndOfE(units string) (*Goment, error) {
+	unit, err := ParseUnit(units)
+	if err != nil {
+		return g, err
+	}
+	return g.EndOfUnit(unit), nil
+}
+
+// EndOfUnit mutates the original Goment by setting it to the end of the unit.
+func (g *Goment) EndOfUnit(unit Unit) *Goment {
+	switch unit {
+	case Year:
 		g.endOfYear()
-	case "Q", "quarter", "quarters":
+	case Quarter:
 		g.endOfQuarter()
-	case "M", "month", "months":
+	case Month:
 		g.endOfMonth()
-	case "w", "week", "weeks":
+	case Week:
 		g.endOfWeek()
-	case "W", "isoWeek", "isoWeeks":
+	case ISOWeek:
 		g.endOfISOWeek()
-	case "d", "day", "days", "date":
+	case Day:
 		g.endOfDay()
-	case "h", "hour", "hours":
+	case Hour:
 		g.endOfHour()
-	case "m", "minute", "minutes":
+	case Minute:
 		g.endOfMinute()
-	case "s", "second", "seconds":
+	case Second:
 		g.endOfSecond()
+	case Millisecond:
+		g.endOfMillisecond()
+	case JalaliYear:
+		g.endOfCalendarYear(jalali)
+	case JalaliMonth:
+		g.endOfCalendarMonth(jalali)
+	case HijriYear:
+		g.endOfCalendarYear(g.hijri)
+	case HijriMonth:
+		g.endOfCalendarMonth(g.hijri)
 	}
 	return g
 }
@@ -123,3 +177,7 @@ func (g *Goment) endOfMinute() *Goment {
 func (g *Goment) endOfSecond() *Goment {
 	return g.SetNanosecond(999999999)
 }
+
+func (g *Goment) endOfMillisecond() *Goment {
+	return g.startOfMillisecond().addNanoseconds(999999)
+}
@@ -184,3 +184,23 @@ func TestEndOfSecond(t *testing.T) {
 	lib.EndOf("second")
 	assert.Equal(t, simpleTime(end).Format(), lib.Format())
 }
+
+func TestStartOfEAndEndOfE(t *testing.T) {
+	assert := assert.New(t)
+
+	lib := simpleTime(time.Date(2011, 1, 2, 3, 4, 5, 6, time.UTC))
+
+	_, err := lib.StartOfE("dya")
+	assert.EqualError(err, "Invalid unit dya")
+	_, err = lib.EndOfE("dya")
+	assert.EqualError(err, "Invalid unit dya")
+	assert.Equal(3, lib.Hour())
+
+	_, err = lib.StartOfE("day")
+	assert.Nil(err)
+	assert.Equal(0, lib.Hour())
+
+	_, err = lib.EndOfE("day")
+	assert.Nil(err)
+	assert.Equal(23, lib.Hour())
+}
@@ -0,0 +1,155 @@
+package goment
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// Unit is a unit of time, used to get, set, manipulate, compare & diff Goments.
+type Unit int
+
+// The units of time supported by Goment.
+const (
+	// InvalidUnit is returned by ParseUnit when the units are not recognised. Operations ignore it.
+	InvalidUnit Unit = iota
+	// Year is a calendar year.
+	Year
+	// Quarter is three calendar months.
+	Quarter
+	// Month is a calendar month.
+	Month
+	// Week is a week that starts on the locale's first day of the week.
+	Week
+	// ISOWeek is a week that starts on Monday.
+	ISOWeek
+	// Day is a calendar day. Getting or setting a Day uses the day of the month, but the "d", "day" & "days" strings
+	// get & set the day of the week in Get & Set.
+	Day
+	// Hour is an hour.
+	Hour
+	// Minute is a minute.
+	Minute
+	// Second is a second.
+	Second
+	// Millisecond is a millisecond.
+	Millisecond
+	// Nanosecond is a nanosecond.
+	Nanosecond
+	// JalaliYear is a year in the Jalali calendar. Durations don't support it.
+	JalaliYear
+	// JalaliMonth is a month in the Jalali calendar. Durations don't support it.
+	JalaliMonth
+	// HijriYear is a year in the Hijri calendar. Durations don't support it.
+	HijriYear
+	// HijriMonth is a month in the Hijri calendar. Durations don't support it.
+	HijriMonth
+)
+
+// unitAliases maps every accepted spelling of a unit to the Unit. Long names are also matched case-insensitively.
+var unitAliases = map[string]Unit{
+	"y": Year, "year": Year, "years": Year,
+	"Q": Quarter, "quarter": Quarter, "quarters": Quarter,
+	"M": Month, "month": Month, "months": Month,
+	"w": Week, "week": Week, "weeks": Week,
+	"W": ISOWeek, "isoWeek": ISOWeek, "isoWeeks": ISOWeek, "isoweek": ISOWeek, "isoweeks": ISOWeek,
+	"d": Day, "day": Day, "days": Day, "D": Day, "date": Day, "dates": Day,
+	"h": Hour, "hour": Hour, "hours": Hour,
+	"m": Minute, "minute": Minute, "minutes": Minute,
+	"s": Second, "second": Second, "seconds": Second,
+	"ms": Millisecond, "millisecond": Millisecond, "milliseconds": Millisecond,
+	"ns": Nanosecond, "nanosecond": Nanosecond, "nanoseconds": Nanosecond,
+	"jy": JalaliYear, "jYear": JalaliYear, "jYears": JalaliYear, "jyear": JalaliYear, "jyears": JalaliYear,
+	"jM": JalaliMonth, "jMonth": JalaliMonth, "jMonths": JalaliMonth, "jmonth": JalaliMonth, "jmonths": JalaliMonth,
+	"iy": HijriYear, "iYear": HijriYear, "iYears": HijriYear, "iyear": HijriYear, "iyears": HijriYear,
+	"iM": HijriMonth, "iMonth": HijriMonth, "iMonths": HijriMonth, "imonth": HijriMonth, "imonths": HijriMonth,
+}
+
+var unitNames = map[Unit]string{
+	Year:        "year",
+	Quarter:     "quarter",
+	Month:       "month",
+	Week:        "week",
+	ISOWeek:     "isoWeek",
+	Day:         "day",
+	Hour:        "hour",
+	Minute:      "minute",
+	Second:      "second",
+	Millisecond: "millisecond",
+	Nanosecond:  "nanosecond",
+	JalaliYear:  "jYear",
+	JalaliMonth: "jMonth",
+	HijriYear:   "iYear",
+	HijriMonth:  "iMonth",
+}
+
+// ParseUnit returns the Unit for a unit string like "y", "year" or "years".
+func ParseUnit(units string) (Unit, error) {
+	if u, ok := unitAliases[units]; ok {
+		return u, nil
+	}
+	if len(units) > 1 {
+		if u, ok := unitAliases[strings.ToLower(units)]; ok {
+			return u, nil
+		}
+	}
+	return InvalidUnit, errors.New("Invalid unit " + units)
+}
+
+// String returns the name of the Unit, like "year".
+func (u Unit) String() string {
+	if name, ok := unitNames[u]; ok {
+		return name
+	}
+	return "invalid"
+}
+
+// IsValid checks if the Unit is one of the supported units.
+func (u Unit) IsValid() bool {
+	_, ok := unitNames[u]
+	return ok
+}
+
+// isWeekdayUnits checks if the units are a day string that Get & Set treat as the day of the week, like moment.
+func isWeekdayUnits(units string) bool {
+	switch strings.ToLower(units) {
+	case "d", "day", "days":
+		return units != "D"
+	}
+	return false
+}
+
+// unitFromArg returns the Unit for a Unit or unit string argument, or InvalidUnit.
+func unitFromArg(arg interface{}) Unit {
+	switch v := arg.(type) {
+	case Unit:
+		return v
+	case string:
+		u, _ := ParseUnit(v)
+		return u
+	}
+	return InvalidUnit
+}
+
+// isUnitArg checks if an argument is a Unit or a unit string.
+func isUnitArg(arg interface{}) bool {
+	switch arg.(type) {
+	case Unit, string:
+		return true
+	}
+	return false
+}
+
+// unitFromArgE returns the Unit for a Unit or unit string argument, or an error if it is not a supported unit.
+func unitFromArgE(arg interface{}) (Unit, error) {
+	switch v := arg.(type) {
+	case Unit:
+		if !v.IsValid() {
+			return InvalidUnit, errors.New("Invalid unit " + strconv.Itoa(int(v)))
+		}
+		return v, nil
+	case string:
+		return ParseUnit(v)
+	}
+	return InvalidUnit, errors.New("Units must be a string or Unit")
+}
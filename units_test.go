@@ -0,0 +1,104 @@
+package goment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseUnit(t *testing.T) {
+	assert := assert.New(t)
+
+	units := map[string]Unit{
+		"y":            Year,
+		"years":        Year,
+		"Q":            Quarter,
+		"quarter":      Quarter,
+		"M":            Month,
+		"Months":       Month,
+		"w":            Week,
+		"weeks":        Week,
+		"W":            ISOWeek,
+		"isoWeek":      ISOWeek,
+		"ISOWEEKS":     ISOWeek,
+		"d":            Day,
+		"D":            Day,
+		"date":         Day,
+		"days":         Day,
+		"h":            Hour,
+		"HOURS":        Hour,
+		"m":            Minute,
+		"minute":       Minute,
+		"s":            Second,
+		"seconds":      Second,
+		"ms":           Millisecond,
+		"milliseconds": Millisecond,
+		"ns":           Nanosecond,
+		"nanosecond":   Nanosecond,
+	}
+
+	for units, unit := range units {
+		parsed, err := ParseUnit(units)
+		assert.Nil(err, units)
+		assert.Equal(unit, parsed, units)
+	}
+
+	unit, err := ParseUnit("yeers")
+	assert.Equal(InvalidUnit, unit)
+	assert.EqualError(err, "Invalid unit yeers")
+
+	_, err = ParseUnit("Y")
+	assert.EqualError(err, "Invalid unit Y")
+}
+
+func TestUnitString(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("year", Year.String())
+	assert.Equal("isoWeek", ISOWeek.String())
+	assert.Equal("nanosecond", Nanosecond.String())
+	assert.Equal("jMonth", JalaliMonth.String())
+	assert.Equal("iYear", HijriYear.String())
+	assert.Equal("invalid", InvalidUnit.String())
+	assert.True(Day.IsValid())
+	assert.False(Unit(99).IsValid())
+}
+
+func TestUnitsAreSupportedByEveryOperation(t *testing.T) {
+	assert := assert.New(t)
+
+	lib := simpleTime(time.Date(2015, 4, 6, 10, 11, 12, 456789000, time.UTC))
+	other := simpleTime(time.Date(2015, 10, 20, 10, 11, 12, 456789000, time.UTC))
+
+	assert.Equal(2, lib.GetUnit(Quarter))
+	assert.Equal(15, lib.GetUnit(Week))
+	assert.Equal(15, lib.GetUnit(ISOWeek))
+	assert.Equal(6, lib.GetUnit(Day))
+	assert.Equal(0, lib.GetUnit(InvalidUnit))
+
+	assert.Equal(-2, lib.DiffUnit(other, Quarter))
+	assert.Equal(-2, lib.Diff(other, "quarters"))
+	assert.Equal(-28, lib.DiffUnit(other, ISOWeek))
+	assert.Equal(-1500000, lib.Clone().SubtractUnit(1500, Second).DiffUnit(lib, Millisecond))
+	assert.Equal(-1500, lib.Clone().SubtractUnit(1500, Nanosecond).Diff(lib, "ns"))
+
+	assert.Equal("2015-10-06T10:11:12+00:00", lib.Clone().SetUnit(Quarter, 4).Format())
+	assert.Equal("2015-04-07T10:11:12+00:00", lib.Clone().AddUnit(1, Day).Format())
+	assert.Equal("2015-04-13T10:11:12+00:00", lib.Clone().Add(1, ISOWeek).Format())
+	assert.Equal("2015-04-05T10:11:12+00:00", lib.Clone().Subtract(1, "D").Format())
+	assert.Equal("2015-04-06T10:11:12+00:00", lib.Clone().AddUnit(1, InvalidUnit).Format())
+
+	assert.Equal(456000000, lib.Clone().StartOfUnit(Millisecond).Nanosecond())
+	assert.Equal(456999999, lib.Clone().EndOf("ms").Nanosecond())
+	assert.Equal("2015-04-01T00:00:00+00:00", lib.Clone().StartOfUnit(Quarter).Format())
+
+	assert.True(lib.IsSameUnit(lib.Clone().AddUnit(3, Hour), Day))
+	assert.True(lib.IsBeforeUnit(other, Month))
+	assert.True(other.IsAfterUnit(lib, Quarter))
+	assert.True(lib.IsSameOrBeforeUnit(other, Year))
+	assert.True(other.IsSameOrAfterUnit(lib, Year))
+	assert.True(lib.IsBetweenUnit(lib, other, Month, "[)"))
+	assert.False(lib.IsBetweenUnit(lib, other, Month, "()"))
+	assert.True(lib.IsSame(other, Year))
+}
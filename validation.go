@@ -0,0 +1,44 @@
+package goment
+
+// ParsingFlags contains the details of how a Goment was parsed from a string and format.
+type ParsingFlags struct {
+	// Empty is true if none of the format tokens matched any input.
+	Empty bool
+	// UnusedTokens contains the format tokens that did not match any input.
+	UnusedTokens []string
+	// UnusedInput contains the parts of the input that were not matched by a format token.
+	UnusedInput []string
+	// Overflow is the unit that was out of range (e.g. month 13), or empty if none overflowed.
+	Overflow string
+	// Meridiem is the parsed meridiem (e.g. am/pm), or empty if none was parsed.
+	Meridiem string
+	// Strict is true if the Goment was parsed in strict mode.
+	Strict bool
+	// Format is the format the Goment was parsed with. When a list of formats is supplied, it is the best match.
+	Format string
+	// Score rates how closely the input matched the format, with 0 being an exact match.
+	Score int
+}
+
+// IsValid checks if the Goment was created successfully. A Goment returned with an error, or parsed
+// with values out of range or without any format token matching the input, is not valid.
+func (g *Goment) IsValid() bool {
+	return g.valid && g.flags.Overflow == "" && !g.flags.Empty
+}
+
+// InvalidAt returns the unit that overflowed when parsing the Goment, or an empty string if none did.
+func (g *Goment) InvalidAt() string {
+	return g.flags.Overflow
+}
+
+// ParsingFlags returns the details of how the Goment was parsed.
+func (g *Goment) ParsingFlags() ParsingFlags {
+	return g.flags.clone()
+}
+
+func (pf ParsingFlags) clone() ParsingFlags {
+	copy := pf
+	copy.UnusedTokens = append([]string(nil), pf.UnusedTokens...)
+	copy.UnusedInput = append([]string(nil), pf.UnusedInput...)
+	return copy
+}
@@ -0,0 +1,95 @@
+package goment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValid(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(simpleNow().IsValid())
+	assert.True(simpleTime(time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)).IsValid())
+	assert.True(simpleString("2024-01-05").IsValid())
+	assert.True(simpleFormat("2024-01-05", "YYYY-MM-DD").IsValid())
+	assert.True(simpleFormat("2024-01-05", "YYYY-MM-DD").Clone().IsValid())
+
+	lib, err := New("not a date")
+	assert.Error(err)
+	assert.False(lib.IsValid())
+
+	lib, err = New("2024-13-45", "YYYY-MM-DD")
+	assert.NoError(err, "non-strict parsing still returns the normalized date")
+	assert.False(lib.IsValid())
+	assert.False(lib.Clone().IsValid())
+
+	assert.False(simpleFormat("foo", "YYYY").IsValid(), "no tokens matched")
+}
+
+func TestInvalidAt(t *testing.T) {
+	assert := assert.New(t)
+
+	invalidAt := map[string][]string{
+		"":       []string{"2024-01-05 23:59:59", "2024-02-29 24:00:00"},
+		"month":  []string{"2024-13-05 00:00:00", "2024-00-05 00:00:00", "2024-13-45 00:00:00"},
+		"date":   []string{"2023-02-29 00:00:00", "2024-04-31 00:00:00", "2024-01-00 00:00:00"},
+		"hour":   []string{"2024-01-05 25:00:00", "2024-01-05 24:01:00"},
+		"minute": []string{"2024-01-05 12:60:00"},
+		"second": []string{"2024-01-05 12:00:60"},
+	}
+
+	for unit, dates := range invalidAt {
+		for _, date := range dates {
+			assert.Equal(unit, simpleFormat(date, "YYYY-MM-DD HH:mm:ss").InvalidAt(), date)
+		}
+	}
+
+	assert.Equal("date", simpleFormat("2023 366", "YYYY DDDD").InvalidAt())
+	assert.Equal("week", simpleFormat("2024 54", "GGGG WW").InvalidAt())
+	assert.Equal("weekday", simpleFormat("2024 10 8", "GGGG WW E").InvalidAt())
+	assert.Equal("", simpleNow().InvalidAt())
+}
+
+func TestParsingFlags(t *testing.T) {
+	assert := assert.New(t)
+
+	flags := simpleFormat("March 5th, 2024 at 4pm garbage", "MMMM Do, YYYY h a ss").ParsingFlags()
+	assert.False(flags.Empty)
+	assert.False(flags.Strict)
+	assert.Equal([]string{"ss"}, flags.UnusedTokens)
+	assert.Equal([]string{"at ", "garbage"}, flags.UnusedInput)
+	assert.Equal("pm", flags.Meridiem)
+	assert.Equal("", flags.Overflow)
+
+	flags = simpleFormat("2024-01-05", "YYYY-MM-DD").ParsingFlags()
+	assert.Empty(flags.UnusedTokens)
+	assert.Empty(flags.UnusedInput)
+
+	// Literal text doesn't have to match when parsing loosely, and bracketed text is ignored.
+	flags = simpleFormat("2024/01/05 at 10", "YYYY-MM-DD [T] HH").ParsingFlags()
+	assert.Empty(flags.UnusedTokens)
+	assert.Equal([]string{"/", "/", " at "}, flags.UnusedInput)
+	assert.Equal("2024-01-05 10:00", simpleFormat("2024/01/05 at 10", "YYYY-MM-DD [T] HH").Format("YYYY-MM-DD HH:mm"))
+
+	lib, err := NewStrict("2024-01-05", "YYYY-MM-DD")
+	assert.NoError(err)
+	assert.True(lib.ParsingFlags().Strict)
+
+	lib, err = NewStrict("2024-1-05 extra", "YYYY-MM-DD")
+	assert.Error(err)
+	assert.False(lib.IsValid())
+	assert.True(lib.ParsingFlags().Strict)
+	assert.Equal([]string{"MM", "-", "DD"}, lib.ParsingFlags().UnusedTokens)
+
+	lib, err = NewStrict("2024-13-45", "YYYY-MM-DD")
+	assert.EqualError(err, "Parsed date has values out of range")
+	assert.False(lib.IsValid())
+	assert.Equal("month", lib.InvalidAt())
+
+	// The returned flags are a copy.
+	lib = simpleFormat("2024 x", "YYYY")
+	lib.ParsingFlags().UnusedInput[0] = "changed"
+	assert.Equal([]string{" x"}, lib.ParsingFlags().UnusedInput)
+}